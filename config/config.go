@@ -3,6 +3,8 @@ package config
 import (
 	"fmt"
 	"os"
+	"strings"
+	"time"
 
 	"github.com/joho/godotenv"
 	"github.com/spf13/cast"
@@ -15,14 +17,44 @@ type Config struct {
 	Environment string //development, staging, production
 
 	GRPCPort	string
+	HTTPPort	string
+
+	ShutdownTimeout time.Duration
+
+	HealthCheckInterval time.Duration
+	HealthCheckTimeout  time.Duration
+
+	DefaultRPCTimeout time.Duration
+	RPCTimeouts       map[string]time.Duration
+
+	AuthEnabled       bool
+	AuthPublicMethods []string
+	JWTSecret         string
+	JWKSFile          string
+	JWTIssuer         string
+	JWTAudience       string
+
+	DefaultPageSize int32
+	MaxPageSize     int32
 
 	PostgresHost     string
 	PostgresPort     int
 	PostgresDatabase string
 	PostgresUser     string
 	PostgresPassword string
+
+	TxIsolationLevel string
+	TxMaxRetries     int
+
+	PurgeRetention time.Duration
+	PurgeInterval  time.Duration
 }
 
+// defaultPublicMethods are the RPCs anyone may call without a token:
+// reads, health checks and reflection.
+const defaultPublicMethods = "SayHello,GetArticleList,GetArticleById,GetArticleBySlug,ListTags,SearchArticles," +
+	"GetAuthorList,GetAuthorById,grpc.health.v1.Health,grpc.reflection.v1alpha.ServerReflection"
+
 // Load ...
 func Load() Config {
 	if err := godotenv.Load(); err != nil {
@@ -36,6 +68,25 @@ func Load() Config {
 	config.Environment = cast.ToString(getOrReturnDefaultValue("ENVIRONMENT", "development"))
 
 	config.GRPCPort = cast.ToString(getOrReturnDefaultValue("GRPC_PORT", ":9001"))
+	config.HTTPPort = cast.ToString(getOrReturnDefaultValue("HTTP_PORT", ":7070"))
+
+	config.ShutdownTimeout = cast.ToDuration(getOrReturnDefaultValue("SHUTDOWN_TIMEOUT", "15s"))
+
+	config.HealthCheckInterval = cast.ToDuration(getOrReturnDefaultValue("HEALTH_CHECK_INTERVAL", "5s"))
+	config.HealthCheckTimeout = cast.ToDuration(getOrReturnDefaultValue("HEALTH_CHECK_TIMEOUT", "2s"))
+
+	config.DefaultRPCTimeout = cast.ToDuration(getOrReturnDefaultValue("DEFAULT_RPC_TIMEOUT", "10s"))
+	config.RPCTimeouts = parseTimeouts(cast.ToString(getOrReturnDefaultValue("RPC_TIMEOUTS", "")))
+
+	config.AuthEnabled = cast.ToBool(getOrReturnDefaultValue("AUTH_ENABLED", true))
+	config.AuthPublicMethods = parseList(cast.ToString(getOrReturnDefaultValue("AUTH_PUBLIC_METHODS", defaultPublicMethods)))
+	config.JWTSecret = cast.ToString(getOrReturnDefaultValue("JWT_HS256_SECRET", ""))
+	config.JWKSFile = cast.ToString(getOrReturnDefaultValue("JWT_JWKS_FILE", ""))
+	config.JWTIssuer = cast.ToString(getOrReturnDefaultValue("JWT_ISSUER", ""))
+	config.JWTAudience = cast.ToString(getOrReturnDefaultValue("JWT_AUDIENCE", ""))
+
+	config.DefaultPageSize = cast.ToInt32(getOrReturnDefaultValue("DEFAULT_PAGE_SIZE", 10))
+	config.MaxPageSize = cast.ToInt32(getOrReturnDefaultValue("MAX_PAGE_SIZE", 100))
 
 	config.PostgresHost = cast.ToString(getOrReturnDefaultValue("POSTGRES_HOST", "127.0.0.1"))
 	config.PostgresPort = cast.ToInt(getOrReturnDefaultValue("POSTGRES_PORT", 5432))
@@ -43,9 +94,72 @@ func Load() Config {
 	config.PostgresUser = cast.ToString(getOrReturnDefaultValue("POSTGRES_USER", "article_user"))
 	config.PostgresPassword = cast.ToString(getOrReturnDefaultValue("POSTGRES_PASSWORD", "article_password"))
 
+	config.TxIsolationLevel = cast.ToString(getOrReturnDefaultValue("TX_ISOLATION_LEVEL", "read committed"))
+	config.TxMaxRetries = cast.ToInt(getOrReturnDefaultValue("TX_MAX_RETRIES", 3))
+
+	config.PurgeRetention = cast.ToDuration(getOrReturnDefaultValue("PURGE_RETENTION", "720h"))
+	config.PurgeInterval = cast.ToDuration(getOrReturnDefaultValue("PURGE_INTERVAL", "1h"))
+
 	return config
 }
 
+// PageSize returns the page size a list call should use: DefaultPageSize
+// when the client did not ask for one, never more than MaxPageSize.
+func (c Config) PageSize(limit int32) int32 {
+	if limit <= 0 {
+		limit = c.DefaultPageSize
+	}
+	if limit > c.MaxPageSize {
+		limit = c.MaxPageSize
+	}
+	return limit
+}
+
+// RPCTimeout returns the deadline for a gRPC method, given as its full
+// name (/ArticleService/GetArticleList). RPC_TIMEOUTS entries may use the
+// full name or just the method name.
+func (c Config) RPCTimeout(fullMethod string) time.Duration {
+	if t, ok := c.RPCTimeouts[fullMethod]; ok {
+		return t
+	}
+	if t, ok := c.RPCTimeouts[fullMethod[strings.LastIndex(fullMethod, "/")+1:]]; ok {
+		return t
+	}
+	return c.DefaultRPCTimeout
+}
+
+// parseTimeouts reads a comma separated list of method=duration pairs,
+// e.g. "SearchArticles=3s,GetArticleList=5s".
+func parseTimeouts(s string) map[string]time.Duration {
+	res := map[string]time.Duration{}
+	for _, entry := range strings.Split(s, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		method, value, ok := strings.Cut(entry, "=")
+		d, err := time.ParseDuration(strings.TrimSpace(value))
+		if !ok || err != nil {
+			fmt.Printf("Ignoring invalid RPC_TIMEOUTS entry %q\n", entry)
+			continue
+		}
+		res[strings.TrimSpace(method)] = d
+	}
+	return res
+}
+
+// parseList reads a comma separated list, skipping empty entries.
+func parseList(s string) []string {
+	var res []string
+	for _, entry := range strings.Split(s, ",") {
+		if entry = strings.TrimSpace(entry); entry != "" {
+			res = append(res, entry)
+		}
+	}
+	return res
+}
+
 func getOrReturnDefaultValue(key string, defaultValue interface{}) interface{} {
 	_, exists := os.LookupEnv(key)
 
@@ -86,7 +86,12 @@ const docTemplate = `{
                 }
             },
             "put": {
-                "description": "update article",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "update article, replacing its tags when the body has a tags list",
                 "consumes": [
                     "application/json"
                 ],
@@ -133,6 +138,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.JSONError"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.JSONError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -142,6 +153,11 @@ const docTemplate = `{
                 }
             },
             "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
                 "description": "create new article",
                 "consumes": [
                     "application/json"
@@ -189,6 +205,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.JSONError"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.JSONError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -248,6 +270,11 @@ const docTemplate = `{
                 }
             },
             "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
                 "description": "delete article by ID",
                 "consumes": [
                     "application/json"
@@ -266,6 +293,12 @@ const docTemplate = `{
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "version the client last read",
+                        "name": "expected_version",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -292,6 +325,18 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/models.JSONError"
                         }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.JSONError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.JSONError"
+                        }
                     }
                 }
             }
@@ -366,6 +411,11 @@ const docTemplate = `{
                 }
             },
             "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
                 "description": "update author",
                 "consumes": [
                     "application/json"
@@ -413,6 +463,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.JSONError"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.JSONError"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -422,6 +478,11 @@ const docTemplate = `{
                 }
             },
             "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
                 "description": "create new author",
                 "consumes": [
                     "application/json"
@@ -469,6 +530,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.JSONError"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.JSONError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -528,6 +595,11 @@ const docTemplate = `{
                 }
             },
             "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
                 "description": "delete author by ID",
                 "consumes": [
                     "application/json"
@@ -546,6 +618,29 @@ const docTemplate = `{
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "version the client last read",
+                        "name": "expected_version",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "reject",
+                            "cascade",
+                            "reassign"
+                        ],
+                        "type": "string",
+                        "description": "what to do with the author's articles",
+                        "name": "policy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "author receiving the articles when policy is reassign",
+                        "name": "reassign_to",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -565,6 +660,12 @@ const docTemplate = `{
                                     }
                                 }
                             ]
+                        },
+                        "headers": {
+                            "X-Articles-Affected": {
+                                "type": "string",
+                                "description": "number of articles deleted or reassigned"
+                            }
                         }
                     },
                     "400": {
@@ -572,6 +673,18 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/models.JSONError"
                         }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.JSONError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.JSONError"
+                        }
                     }
                 }
             }
@@ -596,16 +709,36 @@ const docTemplate = `{
                 "id": {
                     "type": "string"
                 },
+                "language": {
+                    "type": "string",
+                    "example": "english"
+                },
+                "published_at": {
+                    "type": "string"
+                },
+                "slug": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "draft"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "updated_at": {
                     "type": "string"
+                },
+                "version": {
+                    "type": "integer"
                 }
             }
         },
         "models.Author": {
             "type": "object",
-            "required": [
-                "fullname"
-            ],
             "properties": {
                 "created_at": {
                     "type": "string"
@@ -615,15 +748,61 @@ const docTemplate = `{
                 },
                 "fullname": {
                     "type": "string",
-                    "maxLength": 255,
-                    "minLength": 2,
                     "example": "John Doe"
                 },
                 "id": {
                     "type": "string"
                 },
+                "profile": {
+                    "$ref": "#/definitions/models.AuthorProfile"
+                },
                 "updated_at": {
                     "type": "string"
+                },
+                "version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.AuthorProfile": {
+            "type": "object",
+            "properties": {
+                "avatar_url": {
+                    "type": "string",
+                    "example": "https://example.com/avatar.png"
+                },
+                "bio": {
+                    "type": "string",
+                    "maxLength": 5000
+                },
+                "display_name": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "John Doe"
+                },
+                "family_name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "Doe"
+                },
+                "given_name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "John"
+                },
+                "middle_name": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "social_links": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.SocialLink"
+                    }
+                },
+                "website": {
+                    "type": "string",
+                    "example": "https://example.com"
                 }
             }
         },
@@ -653,20 +832,30 @@ const docTemplate = `{
                 },
                 "content": {
                     "$ref": "#/definitions/models.Content"
+                },
+                "language": {
+                    "type": "string",
+                    "example": "english"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 }
             }
         },
         "models.CreateAuthorModel": {
             "type": "object",
-            "required": [
-                "fullname"
-            ],
             "properties": {
                 "fullname": {
                     "type": "string",
                     "maxLength": 255,
                     "minLength": 2,
                     "example": "John Doe"
+                },
+                "profile": {
+                    "$ref": "#/definitions/models.AuthorProfile"
                 }
             }
         },
@@ -705,8 +894,44 @@ const docTemplate = `{
                 "id": {
                     "type": "string"
                 },
+                "language": {
+                    "type": "string",
+                    "example": "english"
+                },
+                "published_at": {
+                    "type": "string"
+                },
+                "slug": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "draft"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "updated_at": {
                     "type": "string"
+                },
+                "version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.SocialLink": {
+            "type": "object",
+            "properties": {
+                "network": {
+                    "type": "string",
+                    "example": "github"
+                },
+                "url": {
+                    "type": "string",
+                    "example": "https://github.com/johndoe"
                 }
             }
         },
@@ -719,8 +944,21 @@ const docTemplate = `{
                 "content": {
                     "$ref": "#/definitions/models.Content"
                 },
+                "expected_version": {
+                    "type": "integer"
+                },
                 "id": {
                     "type": "string"
+                },
+                "language": {
+                    "type": "string",
+                    "example": "english"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 }
             }
         },
@@ -730,6 +968,9 @@ const docTemplate = `{
                 "id"
             ],
             "properties": {
+                "expected_version": {
+                    "type": "integer"
+                },
                 "fullname": {
                     "type": "string",
                     "maxLength": 255,
@@ -738,9 +979,20 @@ const docTemplate = `{
                 },
                 "id": {
                     "type": "string"
+                },
+                "profile": {
+                    "$ref": "#/definitions/models.AuthorProfile"
                 }
             }
         }
+    },
+    "securityDefinitions": {
+        "BearerAuth": {
+            "description": "JWT as \"Bearer \u003ctoken\u003e\"",
+            "type": "apiKey",
+            "name": "Authorization",
+            "in": "header"
+        }
     }
 }`
 
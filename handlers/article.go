@@ -0,0 +1,256 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/uacademy/blogpost/article_service/models"
+	"github.com/uacademy/blogpost/article_service/proto-gen/blogpost"
+)
+
+// CreateArticle godoc
+// @Summary     Create article
+// @Description create new article
+// @Tags        articles
+// @Security    BearerAuth
+// @Accept      json
+// @Produce     json
+// @Param       article body     models.CreateArticleModel true "Article body"
+// @Success     201     {object} models.JSONResult{data=models.Article}
+// @Failure     400     {object} models.JSONError
+// @Failure     401     {object} models.JSONError
+// @Failure     500     {object} models.JSONError
+// @Router      /v1/article [post]
+func (h Handler) CreateArticle(c *gin.Context) {
+	var body models.CreateArticleModel
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, models.JSONError{Error: err.Error()})
+		return
+	}
+
+	article, err := h.article.CreateArticle(c.Request.Context(), &blogpost.CreateArticleRequest{
+		AuthorId: body.AuthorId,
+		Content: &blogpost.Content{
+			Title: body.Content.Title,
+			Body:  body.Content.Body,
+		},
+		Tags:     body.Tags,
+		Language: body.Language,
+	})
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, models.JSONResult{
+		Message: "Article | Create",
+		Data:    toArticleModel(article),
+	})
+}
+
+// GetArticleById godoc
+// @Summary     Get article
+// @Description get article by ID
+// @Tags        articles
+// @Accept      json
+// @Produce     json
+// @Param       id  path     string true "Article ID"
+// @Success     200 {object} models.JSONResult{data=models.PackedArticleModel}
+// @Failure     404 {object} models.JSONError
+// @Router      /v1/article/{id} [get]
+func (h Handler) GetArticleById(c *gin.Context) {
+	article, err := h.article.GetArticleById(c.Request.Context(), &blogpost.GetArticleByIdRequest{
+		Id: c.Param("id"),
+	})
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, models.JSONResult{
+		Message: "Article | GetById",
+		Data:    toPackedArticleModel(article),
+	})
+}
+
+// GetArticleList godoc
+// @Summary     List articles
+// @Description get articles
+// @Tags        articles
+// @Accept      json
+// @Produce     json
+// @Param       offset query    string false "0"
+// @Param       limit  query    string false "10"
+// @Param       search query    string false "smth"
+// @Success     200    {object} models.JSONResult{data=[]models.Article}
+// @Failure     400    {object} models.JSONError
+// @Failure     500    {object} models.JSONError
+// @Router      /v1/article [get]
+func (h Handler) GetArticleList(c *gin.Context) {
+	offset, err := queryInt32(c, "offset")
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	limit, err := queryInt32(c, "limit")
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	res, err := h.article.GetArticleList(c.Request.Context(), &blogpost.GetArticleListRequest{
+		Offset: offset,
+		Limit:  limit,
+		Search: c.Query("search"),
+	})
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	articles := make([]models.Article, 0, len(res.Articles))
+	for _, a := range res.Articles {
+		articles = append(articles, toArticleModel(a))
+	}
+
+	c.JSON(http.StatusOK, models.JSONResult{
+		Message: "Article | GetList",
+		Data:    articles,
+	})
+}
+
+// UpdateArticle godoc
+// @Summary     Update article
+// @Description update article, replacing its tags when the body has a tags list
+// @Tags        articles
+// @Security    BearerAuth
+// @Accept      json
+// @Produce     json
+// @Param       article body     models.UpdateArticleModel true "Article body"
+// @Success     200     {object} models.JSONResult{data=models.Article}
+// @Failure     400     {object} models.JSONError
+// @Failure     401     {object} models.JSONError
+// @Failure     500     {object} models.JSONError
+// @Router      /v1/article [put]
+func (h Handler) UpdateArticle(c *gin.Context) {
+	var body models.UpdateArticleModel
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, models.JSONError{Error: err.Error()})
+		return
+	}
+
+	req := &blogpost.UpdateArticleRequest{
+		Id: body.Id,
+		Content: &blogpost.Content{
+			Title: body.Content.Title,
+			Body:  body.Content.Body,
+		},
+		Tags:            body.Tags,
+		Language:        body.Language,
+		ExpectedVersion: body.ExpectedVersion,
+	}
+
+	// Tags are only replaced when the mask names them, so a body that
+	// carries tags, even an empty list, names them next to the defaults.
+	if body.Tags != nil {
+		paths := []string{"content", "tags"}
+		if body.Language != "" {
+			paths = append(paths, "language")
+		}
+		req.UpdateMask = &fieldmaskpb.FieldMask{Paths: paths}
+	}
+
+	article, err := h.article.UpdateArticle(c.Request.Context(), req)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, models.JSONResult{
+		Message: "Article | Update",
+		Data:    toArticleModel(article),
+	})
+}
+
+// DeleteArticle godoc
+// @Summary     Delete article
+// @Description delete article by ID
+// @Tags        articles
+// @Security    BearerAuth
+// @Accept      json
+// @Produce     json
+// @Param       id               path     string true  "Article ID"
+// @Param       expected_version query    string false "version the client last read"
+// @Success     200              {object} models.JSONResult{data=models.Article}
+// @Failure     400              {object} models.JSONError
+// @Failure     401              {object} models.JSONError
+// @Failure     409              {object} models.JSONError
+// @Router      /v1/article/{id} [delete]
+func (h Handler) DeleteArticle(c *gin.Context) {
+	expectedVersion, err := queryInt64(c, "expected_version")
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	article, err := h.article.DeleteArticle(c.Request.Context(), &blogpost.DeleteArticleRequest{
+		Id:              c.Param("id"),
+		ExpectedVersion: expectedVersion,
+	})
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, models.JSONResult{
+		Message: "Article | Delete",
+		Data:    toArticleModel(article),
+	})
+}
+
+func toContentModel(content *blogpost.Content) models.Content {
+	return models.Content{
+		Title: content.GetTitle(),
+		Body:  content.GetBody(),
+	}
+}
+
+func toArticleModel(a *blogpost.Article) models.Article {
+	return models.Article{
+		Id:          a.Id,
+		Content:     toContentModel(a.Content),
+		AuthorId:    a.AuthorId,
+		Slug:        a.Slug,
+		Status:      statusName(a.Status),
+		Tags:        a.Tags,
+		Language:    a.Language,
+		PublishedAt: formatTime(a.PublishTime),
+		CreatedAt:   formatTime(a.CreateTime),
+		UpdatedAt:   formatTime(a.UpdateTime),
+		Version:     a.Version,
+	}
+}
+
+func toPackedArticleModel(a *blogpost.GetArticleByIdResponse) models.PackedArticleModel {
+	return models.PackedArticleModel{
+		Id:      a.Id,
+		Content: toContentModel(a.Content),
+		Author: models.Author{
+			Id:        a.Author.GetId(),
+			Fullname:  a.Author.GetFullname(),
+			CreatedAt: formatTime(a.Author.GetCreateTime()),
+			UpdatedAt: formatTime(a.Author.GetUpdateTime()),
+		},
+		Slug:        a.Slug,
+		Status:      statusName(a.Status),
+		Tags:        a.Tags,
+		Language:    a.Language,
+		PublishedAt: formatTime(a.PublishTime),
+		CreatedAt:   formatTime(a.CreateTime),
+		UpdatedAt:   formatTime(a.UpdateTime),
+		Version:     a.Version,
+	}
+}
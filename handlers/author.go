@@ -0,0 +1,277 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/uacademy/blogpost/article_service/models"
+	"github.com/uacademy/blogpost/article_service/proto-gen/blogpost"
+)
+
+// CreateAuthor godoc
+// @Summary     Create author
+// @Description create new author
+// @Tags        authors
+// @Security    BearerAuth
+// @Accept      json
+// @Produce     json
+// @Param       author body     models.CreateAuthorModel true "Author body"
+// @Success     201    {object} models.JSONResult{data=models.Author}
+// @Failure     400    {object} models.JSONError
+// @Failure     401    {object} models.JSONError
+// @Failure     500    {object} models.JSONError
+// @Router      /v1/author [post]
+func (h Handler) CreateAuthor(c *gin.Context) {
+	var body models.CreateAuthorModel
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, models.JSONError{Error: err.Error()})
+		return
+	}
+
+	author, err := h.author.CreateAuthor(c.Request.Context(), &blogpost.CreateAuthorRequest{
+		Fullname: body.Fullname,
+		Profile:  fromAuthorProfileModel(body.Profile),
+	})
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, models.JSONResult{
+		Message: "Author | Create",
+		Data:    toAuthorModel(author),
+	})
+}
+
+// GetAuthorById godoc
+// @Summary     Get author
+// @Description get author by ID
+// @Tags        authors
+// @Accept      json
+// @Produce     json
+// @Param       id  path     string true "Author ID"
+// @Success     200 {object} models.JSONResult{data=models.Author}
+// @Failure     404 {object} models.JSONError
+// @Router      /v1/author/{id} [get]
+func (h Handler) GetAuthorById(c *gin.Context) {
+	author, err := h.author.GetAuthorById(c.Request.Context(), &blogpost.GetAuthorByIdRequest{
+		Id: c.Param("id"),
+	})
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, models.JSONResult{
+		Message: "Author | GetById",
+		Data: models.Author{
+			Id:        author.Id,
+			Fullname:  author.Fullname,
+			CreatedAt: formatTime(author.CreateTime),
+			UpdatedAt: formatTime(author.UpdateTime),
+			Version:   author.Version,
+			Profile:   toAuthorProfileModel(author.Profile),
+		},
+	})
+}
+
+// GetAuthorList godoc
+// @Summary     List authors
+// @Description get authors
+// @Tags        authors
+// @Accept      json
+// @Produce     json
+// @Param       offset query    string false "0"
+// @Param       limit  query    string false "10"
+// @Param       search query    string false "smth"
+// @Success     200    {object} models.JSONResult{data=[]models.Author}
+// @Failure     400    {object} models.JSONError
+// @Failure     500    {object} models.JSONError
+// @Router      /v1/author [get]
+func (h Handler) GetAuthorList(c *gin.Context) {
+	offset, err := queryInt32(c, "offset")
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	limit, err := queryInt32(c, "limit")
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	res, err := h.author.GetAuthorList(c.Request.Context(), &blogpost.GetAuthorListRequest{
+		Offset: offset,
+		Limit:  limit,
+		Search: c.Query("search"),
+	})
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	authors := make([]models.Author, 0, len(res.Authors))
+	for _, a := range res.Authors {
+		authors = append(authors, toAuthorModel(a))
+	}
+
+	c.JSON(http.StatusOK, models.JSONResult{
+		Message: "Author | GetList",
+		Data:    authors,
+	})
+}
+
+// UpdateAuthor godoc
+// @Summary     Update author
+// @Description update author
+// @Tags        authors
+// @Security    BearerAuth
+// @Accept      json
+// @Produce     json
+// @Param       author body     models.UpdateAuthorModel true "Author body"
+// @Success     200    {object} models.JSONResult{data=models.Author}
+// @Failure     400    {object} models.JSONError
+// @Failure     401    {object} models.JSONError
+// @Failure     404    {object} models.JSONError
+// @Router      /v1/author [put]
+func (h Handler) UpdateAuthor(c *gin.Context) {
+	var body models.UpdateAuthorModel
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, models.JSONError{Error: err.Error()})
+		return
+	}
+
+	author, err := h.author.UpdateAuthor(c.Request.Context(), &blogpost.UpdateAuthorRequest{
+		Id:              body.Id,
+		Fullname:        body.Fullname,
+		ExpectedVersion: body.ExpectedVersion,
+		Profile:         fromAuthorProfileModel(body.Profile),
+	})
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, models.JSONResult{
+		Message: "Author | Update",
+		Data:    toAuthorModel(author),
+	})
+}
+
+// DeleteAuthor godoc
+// @Summary     Delete author
+// @Description delete author by ID
+// @Tags        authors
+// @Security    BearerAuth
+// @Accept      json
+// @Produce     json
+// @Param       id               path     string true  "Author ID"
+// @Param       expected_version query    string false "version the client last read"
+// @Param       policy           query    string false "what to do with the author's articles" Enums(reject, cascade, reassign)
+// @Param       reassign_to      query    string false "author receiving the articles when policy is reassign"
+// @Success     200              {object} models.JSONResult{data=models.Author}
+// @Header      200              {string} X-Articles-Affected "number of articles deleted or reassigned"
+// @Failure     400              {object} models.JSONError
+// @Failure     401              {object} models.JSONError
+// @Failure     409              {object} models.JSONError
+// @Router      /v1/author/{id} [delete]
+func (h Handler) DeleteAuthor(c *gin.Context) {
+	expectedVersion, err := queryInt64(c, "expected_version")
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	policy, ok := deleteAuthorPolicies[c.Query("policy")]
+	if !ok {
+		c.JSON(http.StatusBadRequest, models.JSONError{Error: "policy must be one of reject, cascade or reassign"})
+		return
+	}
+
+	var header metadata.MD
+	author, err := h.author.DeleteAuthor(c.Request.Context(), &blogpost.DeleteAuthorRequest{
+		Id:              c.Param("id"),
+		ExpectedVersion: expectedVersion,
+		Policy:          policy,
+		ReassignTo:      c.Query("reassign_to"),
+	}, grpc.Header(&header))
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	if v := header.Get("x-articles-affected"); len(v) > 0 {
+		c.Header("X-Articles-Affected", v[0])
+	}
+	c.JSON(http.StatusOK, models.JSONResult{
+		Message: "Author | Delete",
+		Data:    toAuthorModel(author),
+	})
+}
+
+var deleteAuthorPolicies = map[string]blogpost.DeleteAuthorPolicy{
+	"":         blogpost.DeleteAuthorPolicy_DELETE_AUTHOR_POLICY_REJECT,
+	"reject":   blogpost.DeleteAuthorPolicy_DELETE_AUTHOR_POLICY_REJECT,
+	"cascade":  blogpost.DeleteAuthorPolicy_DELETE_AUTHOR_POLICY_CASCADE,
+	"reassign": blogpost.DeleteAuthorPolicy_DELETE_AUTHOR_POLICY_REASSIGN,
+}
+
+func toAuthorModel(a *blogpost.Author) models.Author {
+	return models.Author{
+		Id:        a.Id,
+		Fullname:  a.Fullname,
+		CreatedAt: formatTime(a.CreateTime),
+		UpdatedAt: formatTime(a.UpdateTime),
+		DeletedAt: formatTime(a.DeleteTime),
+		Version:   a.Version,
+		Profile:   toAuthorProfileModel(a.Profile),
+	}
+}
+
+func toAuthorProfileModel(p *blogpost.AuthorProfile) *models.AuthorProfile {
+	if p == nil {
+		return nil
+	}
+
+	links := make([]models.SocialLink, 0, len(p.SocialLinks))
+	for _, l := range p.SocialLinks {
+		links = append(links, models.SocialLink{Network: l.Network, Url: l.Url})
+	}
+
+	return &models.AuthorProfile{
+		GivenName:   p.GivenName,
+		FamilyName:  p.FamilyName,
+		MiddleName:  p.MiddleName,
+		DisplayName: p.DisplayName,
+		Bio:         p.Bio,
+		AvatarUrl:   p.AvatarUrl,
+		Website:     p.Website,
+		SocialLinks: links,
+	}
+}
+
+func fromAuthorProfileModel(p *models.AuthorProfile) *blogpost.AuthorProfile {
+	if p == nil {
+		return nil
+	}
+
+	links := make([]*blogpost.SocialLink, 0, len(p.SocialLinks))
+	for _, l := range p.SocialLinks {
+		links = append(links, &blogpost.SocialLink{Network: l.Network, Url: l.Url})
+	}
+
+	return &blogpost.AuthorProfile{
+		GivenName:   p.GivenName,
+		FamilyName:  p.FamilyName,
+		MiddleName:  p.MiddleName,
+		DisplayName: p.DisplayName,
+		Bio:         p.Bio,
+		AvatarUrl:   p.AvatarUrl,
+		Website:     p.Website,
+		SocialLinks: links,
+	}
+}
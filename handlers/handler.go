@@ -0,0 +1,106 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/uacademy/blogpost/article_service/models"
+	"github.com/uacademy/blogpost/article_service/proto-gen/blogpost"
+)
+
+// Handler serves the REST API by calling the gRPC services, so requests
+// go through the same interceptors as gRPC clients do.
+type Handler struct {
+	article blogpost.ArticleServiceClient
+	author  blogpost.AuthorServiceClient
+}
+
+// NewHandler ...
+func NewHandler(conn grpc.ClientConnInterface) Handler {
+	return Handler{
+		article: blogpost.NewArticleServiceClient(conn),
+		author:  blogpost.NewAuthorServiceClient(conn),
+	}
+}
+
+// httpStatus maps gRPC codes to the closest HTTP status.
+func httpStatus(code codes.Code) int {
+	switch code {
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Canceled:
+		return 499
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func handleError(c *gin.Context, err error) {
+	st := status.Convert(err)
+	c.JSON(httpStatus(st.Code()), models.JSONError{
+		Error: st.Message(),
+	})
+}
+
+// queryInt32 reads an optional integer query parameter.
+func queryInt32(c *gin.Context, key string) (int32, error) {
+	n, err := queryInt(c, key, 32)
+	return int32(n), err
+}
+
+// queryInt64 reads an optional integer query parameter.
+func queryInt64(c *gin.Context, key string) (int64, error) {
+	return queryInt(c, key, 64)
+}
+
+func queryInt(c *gin.Context, key string, bitSize int) (int64, error) {
+	v := c.Query(key)
+	if v == "" {
+		return 0, nil
+	}
+
+	n, err := strconv.ParseInt(v, 10, bitSize)
+	if err != nil {
+		return 0, status.Errorf(codes.InvalidArgument, "%s must be an integer", key)
+	}
+	return n, nil
+}
+
+func statusName(s blogpost.ArticleStatus) string {
+	if s == blogpost.ArticleStatus_ARTICLE_STATUS_UNSPECIFIED {
+		return ""
+	}
+	return strings.ToLower(strings.TrimPrefix(s.String(), "ARTICLE_STATUS_"))
+}
+
+// formatTime renders ts as RFC 3339 in UTC, or "" when it is unset.
+func formatTime(ts *timestamppb.Timestamp) string {
+	if ts == nil {
+		return ""
+	}
+	return ts.AsTime().UTC().Format(time.RFC3339Nano)
+}
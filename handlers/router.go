@@ -0,0 +1,51 @@
+package handlers
+
+import (
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	swaggerFiles "github.com/swaggo/files"
+	ginSwagger "github.com/swaggo/gin-swagger"
+	"google.golang.org/grpc/metadata"
+
+	// registers the swagger spec served under /swagger
+	_ "github.com/uacademy/blogpost/article_service/docs"
+)
+
+// NewRouter sets up the REST routes documented in docs/swagger.yaml.
+func NewRouter(h Handler) *gin.Engine {
+	r := gin.New()
+	r.Use(gin.Logger(), gin.Recovery())
+
+	v1 := r.Group("/v1", forwardMetadata)
+	{
+		v1.POST("/article", h.CreateArticle)
+		v1.GET("/article", h.GetArticleList)
+		v1.PUT("/article", h.UpdateArticle)
+		v1.GET("/article/:id", h.GetArticleById)
+		v1.DELETE("/article/:id", h.DeleteArticle)
+
+		v1.POST("/author", h.CreateAuthor)
+		v1.GET("/author", h.GetAuthorList)
+		v1.PUT("/author", h.UpdateAuthor)
+		v1.GET("/author/:id", h.GetAuthorById)
+		v1.DELETE("/author/:id", h.DeleteAuthor)
+	}
+
+	r.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
+
+	return r
+}
+
+// forwardMetadata passes the Authorization and X-Request-Id headers on to
+// the gRPC services, which do the actual authentication and auditing.
+func forwardMetadata(c *gin.Context) {
+	ctx := c.Request.Context()
+	for _, key := range []string{"Authorization", "X-Request-Id"} {
+		if h := c.GetHeader(key); h != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, strings.ToLower(key), h)
+		}
+	}
+	c.Request = c.Request.WithContext(ctx)
+	c.Next()
+}
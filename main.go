@@ -1,22 +1,44 @@
 package main
 
 import (
+	"context"
+	"errors"
+	"flag"
 	"fmt"
 	"log"
 	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/reflection"
 
 	"github.com/uacademy/blogpost/article_service/config"
+	"github.com/uacademy/blogpost/article_service/handlers"
 	"github.com/uacademy/blogpost/article_service/proto-gen/blogpost"
 	"github.com/uacademy/blogpost/article_service/services/article"
+	"github.com/uacademy/blogpost/article_service/services/audit"
+	"github.com/uacademy/blogpost/article_service/services/auth"
 	"github.com/uacademy/blogpost/article_service/services/author"
+	"github.com/uacademy/blogpost/article_service/services/health"
+	"github.com/uacademy/blogpost/article_service/services/interceptor"
+	"github.com/uacademy/blogpost/article_service/services/purge"
 	"github.com/uacademy/blogpost/article_service/storage"
 	"github.com/uacademy/blogpost/article_service/storage/postgres"
 )
 
+// @securityDefinitions.apikey BearerAuth
+// @in                         header
+// @name                       Authorization
+// @description                JWT as "Bearer <token>"
 func main() {
+	migrateCmd := flag.String("migrate", "", "run database migrations (up, down or status) and exit")
+	flag.Parse()
+
 	cfg := config.Load()
 
 	psqlConString := fmt.Sprintf(
@@ -28,24 +50,188 @@ func main() {
 		cfg.PostgresDatabase,
 	)
 
-	var stg storage.StorageI
-	stg, err := postgres.InitDb(psqlConString)
+	db, err := postgres.InitDb(psqlConString, postgres.TxConfig{
+		Isolation:  cfg.TxIsolationLevel,
+		MaxRetries: cfg.TxMaxRetries,
+	})
 	if err != nil {
 		panic(err)
 	}
 
+	if *migrateCmd != "" {
+		if err := runMigrate(db, *migrateCmd); err != nil {
+			log.Fatalf("migrate %s: %v", *migrateCmd, err)
+		}
+		return
+	}
+
+	// Migrations run before anything listens, so no request ever sees an
+	// old schema.
+	if err := db.MigrateUp(); err != nil {
+		log.Fatalf("failed to migrate: %v", err)
+	}
+	log.Println("migrations applied")
+
+	var stg storage.StorageI = db
+
 	println("gRPC server tutorial in Go")
 
-	listener, err := net.Listen("tcp", ":9001")
+	listener, err := net.Listen("tcp", cfg.GRPCPort)
 	if err != nil {
 		panic(err)
 	}
 
-	s := grpc.NewServer()
-	blogpost.RegisterArticleServiceServer(s, article.NewArticleService(stg))
-	blogpost.RegisterAuthorServiceServer(s, author.NewAuthorService(stg))
+	unary := []grpc.UnaryServerInterceptor{interceptor.UnaryDeadline(cfg), interceptor.UnaryRequestId}
+	stream := []grpc.StreamServerInterceptor{interceptor.StreamRequestId}
+	if cfg.AuthEnabled {
+		verifier, err := auth.NewVerifier(cfg)
+		if err != nil {
+			log.Fatalf("auth: %v", err)
+		}
+		unary = append(unary, interceptor.UnaryAuth(verifier, cfg.AuthPublicMethods))
+		stream = append(stream, interceptor.StreamAuth(verifier, cfg.AuthPublicMethods))
+	} else {
+		log.Println("AUTH_ENABLED is false, every RPC can be called without a token")
+	}
+
+	s := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(append(unary, interceptor.UnaryValidator)...),
+		grpc.ChainStreamInterceptor(append(stream, interceptor.StreamValidator)...),
+	)
+	changes := postgres.NewChangeListener(psqlConString)
+	blogpost.RegisterArticleServiceServer(s, article.NewArticleService(cfg, stg, changes))
+	blogpost.RegisterAuthorServiceServer(s, author.NewAuthorService(cfg, stg, changes))
+	blogpost.RegisterAuditServiceServer(s, audit.NewAuditService(cfg, stg))
 	reflection.Register(s)
-	if err := s.Serve(listener); err != nil {
-		log.Fatalf("failed to serve: %v", err)
+	warnUnknownTimeouts(cfg, s)
+
+	checker := health.NewChecker(cfg, db,
+		blogpost.ArticleService_ServiceDesc.ServiceName,
+		blogpost.AuthorService_ServiceDesc.ServiceName,
+		blogpost.AuditService_ServiceDesc.ServiceName,
+	)
+	checker.Register(s)
+
+	// The REST gateway calls the gRPC server over loopback so it shares
+	// the interceptor chain with every other client.
+	conn, err := grpc.Dial(listener.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		panic(err)
+	}
+
+	httpServer := &http.Server{
+		Addr:    cfg.HTTPPort,
+		Handler: handlers.NewRouter(handlers.NewHandler(conn)),
+	}
+
+	errCh := make(chan error, 2)
+	go func() {
+		log.Printf("gRPC server listening on %s", listener.Addr())
+		if err := s.Serve(listener); err != nil {
+			errCh <- fmt.Errorf("grpc: %w", err)
+		}
+	}()
+	go func() {
+		log.Printf("HTTP server listening on %s", cfg.HTTPPort)
+		if err := httpServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			errCh <- fmt.Errorf("http: %w", err)
+		}
+	}()
+
+	checker.SetReady()
+	log.Println("ready to serve")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go checker.Run(ctx)
+	go purge.NewPurger(cfg, stg).Run(ctx)
+	go changes.Run(ctx)
+
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+
+	select {
+	case sig := <-quit:
+		log.Printf("received %s, shutting down", sig)
+	case err := <-errCh:
+		log.Printf("server failed: %v, shutting down", err)
+	}
+
+	cancel()
+	checker.Shutdown()
+
+	shutdown(cfg.ShutdownTimeout, s, httpServer, conn, db)
+}
+
+// shutdown stops accepting new requests and waits up to timeout for the
+// in-flight ones before forcing the servers down and closing the database.
+func shutdown(timeout time.Duration, s *grpc.Server, httpServer *http.Server, conn *grpc.ClientConn, db *postgres.Postgres) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	// HTTP goes first: its handlers are gRPC clients of s.
+	log.Println("stopping HTTP server")
+	if err := httpServer.Shutdown(ctx); err != nil {
+		log.Printf("HTTP server did not drain in time: %v", err)
+		httpServer.Close()
+	}
+	conn.Close()
+
+	log.Println("stopping gRPC server")
+	stopped := make(chan struct{})
+	go func() {
+		s.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+		log.Println("gRPC server drained")
+	case <-ctx.Done():
+		log.Println("gRPC server did not drain in time, forcing stop")
+		s.Stop()
+	}
+
+	log.Println("closing database")
+	if err := db.Close(); err != nil {
+		log.Printf("failed to close database: %v", err)
+	}
+
+	log.Println("shutdown complete")
+}
+
+// warnUnknownTimeouts logs the RPC_TIMEOUTS entries that name no method
+// of s, as they never take effect.
+func warnUnknownTimeouts(cfg config.Config, s *grpc.Server) {
+	known := map[string]bool{}
+	for service, info := range s.GetServiceInfo() {
+		for _, m := range info.Methods {
+			known[m.Name] = true
+			known["/"+service+"/"+m.Name] = true
+		}
+	}
+
+	for name := range cfg.RPCTimeouts {
+		if !known[name] {
+			log.Printf("RPC_TIMEOUTS entry %q matches no method, expected e.g. GetArticleList or /ArticleService/GetArticleList", name)
+		}
+	}
+}
+
+func runMigrate(db *postgres.Postgres, cmd string) error {
+	switch cmd {
+	case "up":
+		return db.MigrateUp()
+	case "down":
+		return db.MigrateDown()
+	case "status":
+		version, dirty, err := db.MigrationStatus()
+		if err != nil {
+			return err
+		}
+		fmt.Printf("version: %d, dirty: %t\n", version, dirty)
+		return nil
+	default:
+		return fmt.Errorf("unknown command %q, expected up, down or status", cmd)
 	}
 }
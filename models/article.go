@@ -0,0 +1,55 @@
+package models
+
+// Content ...
+type Content struct {
+	Title string `json:"title" binding:"required"`
+	Body  string `json:"body" binding:"required"`
+}
+
+// Article ...
+type Article struct {
+	Id          string   `json:"id"`
+	Content     Content  `json:"content"`
+	AuthorId    string   `json:"author_id" binding:"required"`
+	Slug        string   `json:"slug"`
+	Status      string   `json:"status" example:"draft"`
+	Tags        []string `json:"tags"`
+	Language    string   `json:"language" example:"english"`
+	PublishedAt string   `json:"published_at"`
+	CreatedAt   string   `json:"created_at"`
+	UpdatedAt   string   `json:"updated_at"`
+	Version     int64    `json:"version"`
+}
+
+// PackedArticleModel is an article together with its author.
+type PackedArticleModel struct {
+	Id          string   `json:"id"`
+	Content     Content  `json:"content"`
+	Author      Author   `json:"author"`
+	Slug        string   `json:"slug"`
+	Status      string   `json:"status" example:"draft"`
+	Tags        []string `json:"tags"`
+	Language    string   `json:"language" example:"english"`
+	PublishedAt string   `json:"published_at"`
+	CreatedAt   string   `json:"created_at"`
+	UpdatedAt   string   `json:"updated_at"`
+	DeletedAt   string   `json:"deleted_at"`
+	Version     int64    `json:"version"`
+}
+
+// CreateArticleModel ...
+type CreateArticleModel struct {
+	Content  Content  `json:"content"`
+	AuthorId string   `json:"author_id" binding:"required"`
+	Tags     []string `json:"tags"`
+	Language string   `json:"language" example:"english"`
+}
+
+// UpdateArticleModel ...
+type UpdateArticleModel struct {
+	Id              string   `json:"id" binding:"required"`
+	Content         Content  `json:"content"`
+	Tags            []string `json:"tags"`
+	Language        string   `json:"language" example:"english"`
+	ExpectedVersion int64    `json:"expected_version"`
+}
@@ -0,0 +1,44 @@
+package models
+
+// Author ...
+type Author struct {
+	Id        string         `json:"id"`
+	Fullname  string         `json:"fullname" example:"John Doe"`
+	CreatedAt string         `json:"created_at"`
+	UpdatedAt string         `json:"updated_at"`
+	DeletedAt string         `json:"deleted_at"`
+	Version   int64          `json:"version"`
+	Profile   *AuthorProfile `json:"profile"`
+}
+
+// AuthorProfile ...
+type AuthorProfile struct {
+	GivenName   string       `json:"given_name" maxLength:"100" example:"John"`
+	FamilyName  string       `json:"family_name" maxLength:"100" example:"Doe"`
+	MiddleName  string       `json:"middle_name" maxLength:"100"`
+	DisplayName string       `json:"display_name" maxLength:"255" example:"John Doe"`
+	Bio         string       `json:"bio" maxLength:"5000"`
+	AvatarUrl   string       `json:"avatar_url" example:"https://example.com/avatar.png"`
+	Website     string       `json:"website" example:"https://example.com"`
+	SocialLinks []SocialLink `json:"social_links"`
+}
+
+// SocialLink ...
+type SocialLink struct {
+	Network string `json:"network" example:"github"`
+	Url     string `json:"url" example:"https://github.com/johndoe"`
+}
+
+// CreateAuthorModel ...
+type CreateAuthorModel struct {
+	Fullname string         `json:"fullname" minLength:"2" maxLength:"255" example:"John Doe"`
+	Profile  *AuthorProfile `json:"profile"`
+}
+
+// UpdateAuthorModel ...
+type UpdateAuthorModel struct {
+	Id              string         `json:"id" binding:"required"`
+	Fullname        string         `json:"fullname" minLength:"2" maxLength:"255" example:"John Doe"`
+	ExpectedVersion int64          `json:"expected_version"`
+	Profile         *AuthorProfile `json:"profile"`
+}
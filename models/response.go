@@ -0,0 +1,12 @@
+package models
+
+// JSONResult wraps every successful response.
+type JSONResult struct {
+	Message string      `json:"message"`
+	Data    interface{} `json:"data"`
+}
+
+// JSONError is returned instead of JSONResult when a request fails.
+type JSONError struct {
+	Error string `json:"error"`
+}
@@ -1,3 +1,6 @@
-protoc --go_out=./proto-gen \
+protoc -I . \
+-I $(go env GOMODCACHE)/github.com/envoyproxy/protoc-gen-validate@v0.6.13 \
+--go_out=./proto-gen \
 --go-grpc_out=./proto-gen \
+--validate_out="lang=go:./proto-gen" \
 ./protos/*.proto
\ No newline at end of file
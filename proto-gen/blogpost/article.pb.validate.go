@@ -0,0 +1,5051 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: protos/article.proto
+
+package blogpost
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// define the regex for a UUID once up-front
+var _article_uuidPattern = regexp.MustCompile("^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$")
+
+// Validate checks the field values on CreateArticleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateArticleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateArticleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateArticleRequestMultiError, or nil if none found.
+func (m *CreateArticleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateArticleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetAuthorId()); err != nil {
+		err = CreateArticleRequestValidationError{
+			field:  "AuthorId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetContent() == nil {
+		err := CreateArticleRequestValidationError{
+			field:  "Content",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetContent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateArticleRequestValidationError{
+					field:  "Content",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateArticleRequestValidationError{
+					field:  "Content",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetContent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateArticleRequestValidationError{
+				field:  "Content",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(m.GetTags()) > 20 {
+		err := CreateArticleRequestValidationError{
+			field:  "Tags",
+			reason: "value must contain no more than 20 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetTags() {
+		_, _ = idx, item
+
+		if l := utf8.RuneCountInString(item); l < 1 || l > 64 {
+			err := CreateArticleRequestValidationError{
+				field:  fmt.Sprintf("Tags[%v]", idx),
+				reason: "value length must be between 1 and 64 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if utf8.RuneCountInString(m.GetLanguage()) > 32 {
+		err := CreateArticleRequestValidationError{
+			field:  "Language",
+			reason: "value length must be at most 32 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_CreateArticleRequest_Language_Pattern.MatchString(m.GetLanguage()) {
+		err := CreateArticleRequestValidationError{
+			field:  "Language",
+			reason: "value does not match regex pattern \"^[a-z_]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateArticleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *CreateArticleRequest) _validateUuid(uuid string) error {
+	if matched := _article_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// CreateArticleRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateArticleRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateArticleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateArticleRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateArticleRequestMultiError) AllErrors() []error { return m }
+
+// CreateArticleRequestValidationError is the validation error returned by
+// CreateArticleRequest.Validate if the designated constraints aren't met.
+type CreateArticleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateArticleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateArticleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateArticleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateArticleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateArticleRequestValidationError) ErrorName() string {
+	return "CreateArticleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateArticleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateArticleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateArticleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateArticleRequestValidationError{}
+
+var _CreateArticleRequest_Language_Pattern = regexp.MustCompile("^[a-z_]*$")
+
+// Validate checks the field values on UpdateArticleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateArticleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateArticleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateArticleRequestMultiError, or nil if none found.
+func (m *UpdateArticleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateArticleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = UpdateArticleRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// skipping validation for content
+
+	if utf8.RuneCountInString(m.GetEditedBy()) > 255 {
+		err := UpdateArticleRequestValidationError{
+			field:  "EditedBy",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetTags()) > 20 {
+		err := UpdateArticleRequestValidationError{
+			field:  "Tags",
+			reason: "value must contain no more than 20 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetTags() {
+		_, _ = idx, item
+
+		if l := utf8.RuneCountInString(item); l < 1 || l > 64 {
+			err := UpdateArticleRequestValidationError{
+				field:  fmt.Sprintf("Tags[%v]", idx),
+				reason: "value length must be between 1 and 64 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if utf8.RuneCountInString(m.GetLanguage()) > 32 {
+		err := UpdateArticleRequestValidationError{
+			field:  "Language",
+			reason: "value length must be at most 32 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_UpdateArticleRequest_Language_Pattern.MatchString(m.GetLanguage()) {
+		err := UpdateArticleRequestValidationError{
+			field:  "Language",
+			reason: "value does not match regex pattern \"^[a-z_]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetExpectedVersion() < 0 {
+		err := UpdateArticleRequestValidationError{
+			field:  "ExpectedVersion",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdateMask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateArticleRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateArticleRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdateMask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateArticleRequestValidationError{
+				field:  "UpdateMask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateArticleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *UpdateArticleRequest) _validateUuid(uuid string) error {
+	if matched := _article_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// UpdateArticleRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateArticleRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateArticleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateArticleRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateArticleRequestMultiError) AllErrors() []error { return m }
+
+// UpdateArticleRequestValidationError is the validation error returned by
+// UpdateArticleRequest.Validate if the designated constraints aren't met.
+type UpdateArticleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateArticleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateArticleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateArticleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateArticleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateArticleRequestValidationError) ErrorName() string {
+	return "UpdateArticleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateArticleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateArticleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateArticleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateArticleRequestValidationError{}
+
+var _UpdateArticleRequest_Language_Pattern = regexp.MustCompile("^[a-z_]*$")
+
+// Validate checks the field values on DeleteArticleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteArticleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteArticleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteArticleRequestMultiError, or nil if none found.
+func (m *DeleteArticleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteArticleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = DeleteArticleRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetExpectedVersion() < 0 {
+		err := DeleteArticleRequestValidationError{
+			field:  "ExpectedVersion",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteArticleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *DeleteArticleRequest) _validateUuid(uuid string) error {
+	if matched := _article_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// DeleteArticleRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteArticleRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteArticleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteArticleRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteArticleRequestMultiError) AllErrors() []error { return m }
+
+// DeleteArticleRequestValidationError is the validation error returned by
+// DeleteArticleRequest.Validate if the designated constraints aren't met.
+type DeleteArticleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteArticleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteArticleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteArticleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteArticleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteArticleRequestValidationError) ErrorName() string {
+	return "DeleteArticleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteArticleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteArticleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteArticleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteArticleRequestValidationError{}
+
+// Validate checks the field values on GetArticleListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetArticleListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetArticleListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetArticleListRequestMultiError, or nil if none found.
+func (m *GetArticleListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetArticleListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetOffset() < 0 {
+		err := GetArticleListRequestValidationError{
+			field:  "Offset",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetLimit() < 0 {
+		err := GetArticleListRequestValidationError{
+			field:  "Limit",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetSearch()) > 255 {
+		err := GetArticleListRequestValidationError{
+			field:  "Search",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := ArticleStatus_name[int32(m.GetStatus())]; !ok {
+		err := GetArticleListRequestValidationError{
+			field:  "Status",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetTags()) > 20 {
+		err := GetArticleListRequestValidationError{
+			field:  "Tags",
+			reason: "value must contain no more than 20 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetTags() {
+		_, _ = idx, item
+
+		if l := utf8.RuneCountInString(item); l < 1 || l > 64 {
+			err := GetArticleListRequestValidationError{
+				field:  fmt.Sprintf("Tags[%v]", idx),
+				reason: "value length must be between 1 and 64 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if _, ok := TagMatch_name[int32(m.GetTagMatch())]; !ok {
+		err := GetArticleListRequestValidationError{
+			field:  "TagMatch",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	// no validation rules for IncludeTotalCount
+
+	if m.GetAuthorId() != "" {
+
+		if err := m._validateUuid(m.GetAuthorId()); err != nil {
+			err = GetArticleListRequestValidationError{
+				field:  "AuthorId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for CreatedFrom
+
+	// no validation rules for CreatedTo
+
+	// no validation rules for UpdatedFrom
+
+	// no validation rules for UpdatedTo
+
+	if _, ok := _GetArticleListRequest_SortBy_InLookup[m.GetSortBy()]; !ok {
+		err := GetArticleListRequestValidationError{
+			field:  "SortBy",
+			reason: "value must be in list [ created_at updated_at title]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _GetArticleListRequest_SortOrder_InLookup[m.GetSortOrder()]; !ok {
+		err := GetArticleListRequestValidationError{
+			field:  "SortOrder",
+			reason: "value must be in list [ asc desc]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetArticleListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetArticleListRequest) _validateUuid(uuid string) error {
+	if matched := _article_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetArticleListRequestMultiError is an error wrapping multiple validation
+// errors returned by GetArticleListRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetArticleListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetArticleListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetArticleListRequestMultiError) AllErrors() []error { return m }
+
+// GetArticleListRequestValidationError is the validation error returned by
+// GetArticleListRequest.Validate if the designated constraints aren't met.
+type GetArticleListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetArticleListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetArticleListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetArticleListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetArticleListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetArticleListRequestValidationError) ErrorName() string {
+	return "GetArticleListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetArticleListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetArticleListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetArticleListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetArticleListRequestValidationError{}
+
+var _GetArticleListRequest_SortBy_InLookup = map[string]struct{}{
+	"":           {},
+	"created_at": {},
+	"updated_at": {},
+	"title":      {},
+}
+
+var _GetArticleListRequest_SortOrder_InLookup = map[string]struct{}{
+	"":     {},
+	"asc":  {},
+	"desc": {},
+}
+
+// Validate checks the field values on GetArticleByIdRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetArticleByIdRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetArticleByIdRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetArticleByIdRequestMultiError, or nil if none found.
+func (m *GetArticleByIdRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetArticleByIdRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = GetArticleByIdRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetArticleByIdRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetArticleByIdRequest) _validateUuid(uuid string) error {
+	if matched := _article_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetArticleByIdRequestMultiError is an error wrapping multiple validation
+// errors returned by GetArticleByIdRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetArticleByIdRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetArticleByIdRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetArticleByIdRequestMultiError) AllErrors() []error { return m }
+
+// GetArticleByIdRequestValidationError is the validation error returned by
+// GetArticleByIdRequest.Validate if the designated constraints aren't met.
+type GetArticleByIdRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetArticleByIdRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetArticleByIdRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetArticleByIdRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetArticleByIdRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetArticleByIdRequestValidationError) ErrorName() string {
+	return "GetArticleByIdRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetArticleByIdRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetArticleByIdRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetArticleByIdRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetArticleByIdRequestValidationError{}
+
+// Validate checks the field values on GetArticleBySlugRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetArticleBySlugRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetArticleBySlugRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetArticleBySlugRequestMultiError, or nil if none found.
+func (m *GetArticleBySlugRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetArticleBySlugRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetSlug()); l < 1 || l > 255 {
+		err := GetArticleBySlugRequestValidationError{
+			field:  "Slug",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetArticleBySlugRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetArticleBySlugRequestMultiError is an error wrapping multiple validation
+// errors returned by GetArticleBySlugRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetArticleBySlugRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetArticleBySlugRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetArticleBySlugRequestMultiError) AllErrors() []error { return m }
+
+// GetArticleBySlugRequestValidationError is the validation error returned by
+// GetArticleBySlugRequest.Validate if the designated constraints aren't met.
+type GetArticleBySlugRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetArticleBySlugRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetArticleBySlugRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetArticleBySlugRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetArticleBySlugRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetArticleBySlugRequestValidationError) ErrorName() string {
+	return "GetArticleBySlugRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetArticleBySlugRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetArticleBySlugRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetArticleBySlugRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetArticleBySlugRequestValidationError{}
+
+// Validate checks the field values on PublishArticleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PublishArticleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PublishArticleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PublishArticleRequestMultiError, or nil if none found.
+func (m *PublishArticleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PublishArticleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = PublishArticleRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return PublishArticleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *PublishArticleRequest) _validateUuid(uuid string) error {
+	if matched := _article_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// PublishArticleRequestMultiError is an error wrapping multiple validation
+// errors returned by PublishArticleRequest.ValidateAll() if the designated
+// constraints aren't met.
+type PublishArticleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PublishArticleRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PublishArticleRequestMultiError) AllErrors() []error { return m }
+
+// PublishArticleRequestValidationError is the validation error returned by
+// PublishArticleRequest.Validate if the designated constraints aren't met.
+type PublishArticleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PublishArticleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PublishArticleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PublishArticleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PublishArticleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PublishArticleRequestValidationError) ErrorName() string {
+	return "PublishArticleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PublishArticleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPublishArticleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PublishArticleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PublishArticleRequestValidationError{}
+
+// Validate checks the field values on UnpublishArticleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UnpublishArticleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnpublishArticleRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnpublishArticleRequestMultiError, or nil if none found.
+func (m *UnpublishArticleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnpublishArticleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = UnpublishArticleRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UnpublishArticleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *UnpublishArticleRequest) _validateUuid(uuid string) error {
+	if matched := _article_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// UnpublishArticleRequestMultiError is an error wrapping multiple validation
+// errors returned by UnpublishArticleRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UnpublishArticleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnpublishArticleRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnpublishArticleRequestMultiError) AllErrors() []error { return m }
+
+// UnpublishArticleRequestValidationError is the validation error returned by
+// UnpublishArticleRequest.Validate if the designated constraints aren't met.
+type UnpublishArticleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnpublishArticleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnpublishArticleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnpublishArticleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnpublishArticleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnpublishArticleRequestValidationError) ErrorName() string {
+	return "UnpublishArticleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UnpublishArticleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnpublishArticleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnpublishArticleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnpublishArticleRequestValidationError{}
+
+// Validate checks the field values on ArchiveArticleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ArchiveArticleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ArchiveArticleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ArchiveArticleRequestMultiError, or nil if none found.
+func (m *ArchiveArticleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ArchiveArticleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = ArchiveArticleRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ArchiveArticleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ArchiveArticleRequest) _validateUuid(uuid string) error {
+	if matched := _article_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ArchiveArticleRequestMultiError is an error wrapping multiple validation
+// errors returned by ArchiveArticleRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ArchiveArticleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ArchiveArticleRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ArchiveArticleRequestMultiError) AllErrors() []error { return m }
+
+// ArchiveArticleRequestValidationError is the validation error returned by
+// ArchiveArticleRequest.Validate if the designated constraints aren't met.
+type ArchiveArticleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ArchiveArticleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ArchiveArticleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ArchiveArticleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ArchiveArticleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ArchiveArticleRequestValidationError) ErrorName() string {
+	return "ArchiveArticleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ArchiveArticleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sArchiveArticleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ArchiveArticleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ArchiveArticleRequestValidationError{}
+
+// Validate checks the field values on Content with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Content) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Content with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in ContentMultiError, or nil if none found.
+func (m *Content) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Content) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetTitle()); l < 1 || l > 255 {
+		err := ContentValidationError{
+			field:  "Title",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetBody()) < 1 {
+		err := ContentValidationError{
+			field:  "Body",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ContentMultiError(errors)
+	}
+
+	return nil
+}
+
+// ContentMultiError is an error wrapping multiple validation errors returned
+// by Content.ValidateAll() if the designated constraints aren't met.
+type ContentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ContentMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ContentMultiError) AllErrors() []error { return m }
+
+// ContentValidationError is the validation error returned by Content.Validate
+// if the designated constraints aren't met.
+type ContentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ContentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ContentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ContentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ContentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ContentValidationError) ErrorName() string { return "ContentValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ContentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sContent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ContentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ContentValidationError{}
+
+// Validate checks the field values on Article with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Article) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Article with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in ArticleMultiError, or nil if none found.
+func (m *Article) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Article) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if all {
+		switch v := interface{}(m.GetContent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ArticleValidationError{
+					field:  "Content",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ArticleValidationError{
+					field:  "Content",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetContent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ArticleValidationError{
+				field:  "Content",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for AuthorId
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for UpdatedAt
+
+	// no validation rules for Status
+
+	// no validation rules for PublishedAt
+
+	// no validation rules for Slug
+
+	// no validation rules for Language
+
+	// no validation rules for Version
+
+	// no validation rules for DeletedAt
+
+	if all {
+		switch v := interface{}(m.GetCreateTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ArticleValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ArticleValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreateTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ArticleValidationError{
+				field:  "CreateTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdateTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ArticleValidationError{
+					field:  "UpdateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ArticleValidationError{
+					field:  "UpdateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdateTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ArticleValidationError{
+				field:  "UpdateTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetPublishTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ArticleValidationError{
+					field:  "PublishTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ArticleValidationError{
+					field:  "PublishTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPublishTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ArticleValidationError{
+				field:  "PublishTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDeleteTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ArticleValidationError{
+					field:  "DeleteTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ArticleValidationError{
+					field:  "DeleteTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDeleteTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ArticleValidationError{
+				field:  "DeleteTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ArticleMultiError(errors)
+	}
+
+	return nil
+}
+
+// ArticleMultiError is an error wrapping multiple validation errors returned
+// by Article.ValidateAll() if the designated constraints aren't met.
+type ArticleMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ArticleMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ArticleMultiError) AllErrors() []error { return m }
+
+// ArticleValidationError is the validation error returned by Article.Validate
+// if the designated constraints aren't met.
+type ArticleValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ArticleValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ArticleValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ArticleValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ArticleValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ArticleValidationError) ErrorName() string { return "ArticleValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ArticleValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sArticle.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ArticleValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ArticleValidationError{}
+
+// Validate checks the field values on GetArticleListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetArticleListResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetArticleListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetArticleListResponseMultiError, or nil if none found.
+func (m *GetArticleListResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetArticleListResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetArticles() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetArticleListResponseValidationError{
+						field:  fmt.Sprintf("Articles[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetArticleListResponseValidationError{
+						field:  fmt.Sprintf("Articles[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetArticleListResponseValidationError{
+					field:  fmt.Sprintf("Articles[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	// no validation rules for TotalCount
+
+	if len(errors) > 0 {
+		return GetArticleListResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetArticleListResponseMultiError is an error wrapping multiple validation
+// errors returned by GetArticleListResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetArticleListResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetArticleListResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetArticleListResponseMultiError) AllErrors() []error { return m }
+
+// GetArticleListResponseValidationError is the validation error returned by
+// GetArticleListResponse.Validate if the designated constraints aren't met.
+type GetArticleListResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetArticleListResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetArticleListResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetArticleListResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetArticleListResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetArticleListResponseValidationError) ErrorName() string {
+	return "GetArticleListResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetArticleListResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetArticleListResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetArticleListResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetArticleListResponseValidationError{}
+
+// Validate checks the field values on GetArticleByIdResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetArticleByIdResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetArticleByIdResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetArticleByIdResponseMultiError, or nil if none found.
+func (m *GetArticleByIdResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetArticleByIdResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if all {
+		switch v := interface{}(m.GetContent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetArticleByIdResponseValidationError{
+					field:  "Content",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetArticleByIdResponseValidationError{
+					field:  "Content",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetContent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetArticleByIdResponseValidationError{
+				field:  "Content",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetAuthor()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetArticleByIdResponseValidationError{
+					field:  "Author",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetArticleByIdResponseValidationError{
+					field:  "Author",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAuthor()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetArticleByIdResponseValidationError{
+				field:  "Author",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for UpdatedAt
+
+	// no validation rules for Status
+
+	// no validation rules for PublishedAt
+
+	// no validation rules for Slug
+
+	// no validation rules for Language
+
+	// no validation rules for Version
+
+	if all {
+		switch v := interface{}(m.GetCreateTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetArticleByIdResponseValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetArticleByIdResponseValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreateTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetArticleByIdResponseValidationError{
+				field:  "CreateTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdateTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetArticleByIdResponseValidationError{
+					field:  "UpdateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetArticleByIdResponseValidationError{
+					field:  "UpdateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdateTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetArticleByIdResponseValidationError{
+				field:  "UpdateTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetPublishTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetArticleByIdResponseValidationError{
+					field:  "PublishTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetArticleByIdResponseValidationError{
+					field:  "PublishTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPublishTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetArticleByIdResponseValidationError{
+				field:  "PublishTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetArticleByIdResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetArticleByIdResponseMultiError is an error wrapping multiple validation
+// errors returned by GetArticleByIdResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetArticleByIdResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetArticleByIdResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetArticleByIdResponseMultiError) AllErrors() []error { return m }
+
+// GetArticleByIdResponseValidationError is the validation error returned by
+// GetArticleByIdResponse.Validate if the designated constraints aren't met.
+type GetArticleByIdResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetArticleByIdResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetArticleByIdResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetArticleByIdResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetArticleByIdResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetArticleByIdResponseValidationError) ErrorName() string {
+	return "GetArticleByIdResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetArticleByIdResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetArticleByIdResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetArticleByIdResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetArticleByIdResponseValidationError{}
+
+// Validate checks the field values on ArticleRevision with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ArticleRevision) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ArticleRevision with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ArticleRevisionMultiError, or nil if none found.
+func (m *ArticleRevision) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ArticleRevision) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ArticleId
+
+	// no validation rules for Revision
+
+	if all {
+		switch v := interface{}(m.GetContent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ArticleRevisionValidationError{
+					field:  "Content",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ArticleRevisionValidationError{
+					field:  "Content",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetContent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ArticleRevisionValidationError{
+				field:  "Content",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for EditedBy
+
+	// no validation rules for CreatedAt
+
+	if all {
+		switch v := interface{}(m.GetCreateTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ArticleRevisionValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ArticleRevisionValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreateTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ArticleRevisionValidationError{
+				field:  "CreateTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ArticleRevisionMultiError(errors)
+	}
+
+	return nil
+}
+
+// ArticleRevisionMultiError is an error wrapping multiple validation errors
+// returned by ArticleRevision.ValidateAll() if the designated constraints
+// aren't met.
+type ArticleRevisionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ArticleRevisionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ArticleRevisionMultiError) AllErrors() []error { return m }
+
+// ArticleRevisionValidationError is the validation error returned by
+// ArticleRevision.Validate if the designated constraints aren't met.
+type ArticleRevisionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ArticleRevisionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ArticleRevisionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ArticleRevisionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ArticleRevisionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ArticleRevisionValidationError) ErrorName() string { return "ArticleRevisionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ArticleRevisionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sArticleRevision.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ArticleRevisionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ArticleRevisionValidationError{}
+
+// Validate checks the field values on ListArticleRevisionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListArticleRevisionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListArticleRevisionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListArticleRevisionsRequestMultiError, or nil if none found.
+func (m *ListArticleRevisionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListArticleRevisionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetArticleId()); err != nil {
+		err = ListArticleRevisionsRequestValidationError{
+			field:  "ArticleId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetOffset() < 0 {
+		err := ListArticleRevisionsRequestValidationError{
+			field:  "Offset",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetLimit() < 0 {
+		err := ListArticleRevisionsRequestValidationError{
+			field:  "Limit",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListArticleRevisionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ListArticleRevisionsRequest) _validateUuid(uuid string) error {
+	if matched := _article_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ListArticleRevisionsRequestMultiError is an error wrapping multiple
+// validation errors returned by ListArticleRevisionsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListArticleRevisionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListArticleRevisionsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListArticleRevisionsRequestMultiError) AllErrors() []error { return m }
+
+// ListArticleRevisionsRequestValidationError is the validation error returned
+// by ListArticleRevisionsRequest.Validate if the designated constraints
+// aren't met.
+type ListArticleRevisionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListArticleRevisionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListArticleRevisionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListArticleRevisionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListArticleRevisionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListArticleRevisionsRequestValidationError) ErrorName() string {
+	return "ListArticleRevisionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListArticleRevisionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListArticleRevisionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListArticleRevisionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListArticleRevisionsRequestValidationError{}
+
+// Validate checks the field values on ListArticleRevisionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListArticleRevisionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListArticleRevisionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListArticleRevisionsResponseMultiError, or nil if none found.
+func (m *ListArticleRevisionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListArticleRevisionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRevisions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListArticleRevisionsResponseValidationError{
+						field:  fmt.Sprintf("Revisions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListArticleRevisionsResponseValidationError{
+						field:  fmt.Sprintf("Revisions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListArticleRevisionsResponseValidationError{
+					field:  fmt.Sprintf("Revisions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListArticleRevisionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListArticleRevisionsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListArticleRevisionsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListArticleRevisionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListArticleRevisionsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListArticleRevisionsResponseMultiError) AllErrors() []error { return m }
+
+// ListArticleRevisionsResponseValidationError is the validation error returned
+// by ListArticleRevisionsResponse.Validate if the designated constraints
+// aren't met.
+type ListArticleRevisionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListArticleRevisionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListArticleRevisionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListArticleRevisionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListArticleRevisionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListArticleRevisionsResponseValidationError) ErrorName() string {
+	return "ListArticleRevisionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListArticleRevisionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListArticleRevisionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListArticleRevisionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListArticleRevisionsResponseValidationError{}
+
+// Validate checks the field values on GetArticleRevisionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetArticleRevisionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetArticleRevisionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetArticleRevisionRequestMultiError, or nil if none found.
+func (m *GetArticleRevisionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetArticleRevisionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetArticleId()); err != nil {
+		err = GetArticleRevisionRequestValidationError{
+			field:  "ArticleId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetRevision() <= 0 {
+		err := GetArticleRevisionRequestValidationError{
+			field:  "Revision",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetArticleRevisionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetArticleRevisionRequest) _validateUuid(uuid string) error {
+	if matched := _article_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetArticleRevisionRequestMultiError is an error wrapping multiple validation
+// errors returned by GetArticleRevisionRequest.ValidateAll() if the
+// designated constraints aren't met.
+type GetArticleRevisionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetArticleRevisionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetArticleRevisionRequestMultiError) AllErrors() []error { return m }
+
+// GetArticleRevisionRequestValidationError is the validation error returned by
+// GetArticleRevisionRequest.Validate if the designated constraints aren't met.
+type GetArticleRevisionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetArticleRevisionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetArticleRevisionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetArticleRevisionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetArticleRevisionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetArticleRevisionRequestValidationError) ErrorName() string {
+	return "GetArticleRevisionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetArticleRevisionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetArticleRevisionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetArticleRevisionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetArticleRevisionRequestValidationError{}
+
+// Validate checks the field values on RestoreArticleRevisionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RestoreArticleRevisionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RestoreArticleRevisionRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RestoreArticleRevisionRequestMultiError, or nil if none found.
+func (m *RestoreArticleRevisionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RestoreArticleRevisionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetArticleId()); err != nil {
+		err = RestoreArticleRevisionRequestValidationError{
+			field:  "ArticleId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetRevision() <= 0 {
+		err := RestoreArticleRevisionRequestValidationError{
+			field:  "Revision",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetEditedBy()) > 255 {
+		err := RestoreArticleRevisionRequestValidationError{
+			field:  "EditedBy",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RestoreArticleRevisionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *RestoreArticleRevisionRequest) _validateUuid(uuid string) error {
+	if matched := _article_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// RestoreArticleRevisionRequestMultiError is an error wrapping multiple
+// validation errors returned by RestoreArticleRevisionRequest.ValidateAll()
+// if the designated constraints aren't met.
+type RestoreArticleRevisionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RestoreArticleRevisionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RestoreArticleRevisionRequestMultiError) AllErrors() []error { return m }
+
+// RestoreArticleRevisionRequestValidationError is the validation error
+// returned by RestoreArticleRevisionRequest.Validate if the designated
+// constraints aren't met.
+type RestoreArticleRevisionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestoreArticleRevisionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestoreArticleRevisionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestoreArticleRevisionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestoreArticleRevisionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestoreArticleRevisionRequestValidationError) ErrorName() string {
+	return "RestoreArticleRevisionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RestoreArticleRevisionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestoreArticleRevisionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestoreArticleRevisionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestoreArticleRevisionRequestValidationError{}
+
+// Validate checks the field values on DiffArticleRevisionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DiffArticleRevisionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DiffArticleRevisionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DiffArticleRevisionsRequestMultiError, or nil if none found.
+func (m *DiffArticleRevisionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DiffArticleRevisionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetArticleId()); err != nil {
+		err = DiffArticleRevisionsRequestValidationError{
+			field:  "ArticleId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetFromRevision() <= 0 {
+		err := DiffArticleRevisionsRequestValidationError{
+			field:  "FromRevision",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetToRevision() <= 0 {
+		err := DiffArticleRevisionsRequestValidationError{
+			field:  "ToRevision",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DiffArticleRevisionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *DiffArticleRevisionsRequest) _validateUuid(uuid string) error {
+	if matched := _article_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// DiffArticleRevisionsRequestMultiError is an error wrapping multiple
+// validation errors returned by DiffArticleRevisionsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type DiffArticleRevisionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DiffArticleRevisionsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DiffArticleRevisionsRequestMultiError) AllErrors() []error { return m }
+
+// DiffArticleRevisionsRequestValidationError is the validation error returned
+// by DiffArticleRevisionsRequest.Validate if the designated constraints
+// aren't met.
+type DiffArticleRevisionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DiffArticleRevisionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DiffArticleRevisionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DiffArticleRevisionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DiffArticleRevisionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DiffArticleRevisionsRequestValidationError) ErrorName() string {
+	return "DiffArticleRevisionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DiffArticleRevisionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDiffArticleRevisionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DiffArticleRevisionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DiffArticleRevisionsRequestValidationError{}
+
+// Validate checks the field values on DiffLine with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DiffLine) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DiffLine with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DiffLineMultiError, or nil
+// if none found.
+func (m *DiffLine) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DiffLine) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Op
+
+	// no validation rules for Text
+
+	if len(errors) > 0 {
+		return DiffLineMultiError(errors)
+	}
+
+	return nil
+}
+
+// DiffLineMultiError is an error wrapping multiple validation errors returned
+// by DiffLine.ValidateAll() if the designated constraints aren't met.
+type DiffLineMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DiffLineMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DiffLineMultiError) AllErrors() []error { return m }
+
+// DiffLineValidationError is the validation error returned by
+// DiffLine.Validate if the designated constraints aren't met.
+type DiffLineValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DiffLineValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DiffLineValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DiffLineValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DiffLineValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DiffLineValidationError) ErrorName() string { return "DiffLineValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DiffLineValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDiffLine.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DiffLineValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DiffLineValidationError{}
+
+// Validate checks the field values on DiffArticleRevisionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DiffArticleRevisionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DiffArticleRevisionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DiffArticleRevisionsResponseMultiError, or nil if none found.
+func (m *DiffArticleRevisionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DiffArticleRevisionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTitle() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DiffArticleRevisionsResponseValidationError{
+						field:  fmt.Sprintf("Title[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DiffArticleRevisionsResponseValidationError{
+						field:  fmt.Sprintf("Title[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DiffArticleRevisionsResponseValidationError{
+					field:  fmt.Sprintf("Title[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetBody() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DiffArticleRevisionsResponseValidationError{
+						field:  fmt.Sprintf("Body[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DiffArticleRevisionsResponseValidationError{
+						field:  fmt.Sprintf("Body[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DiffArticleRevisionsResponseValidationError{
+					field:  fmt.Sprintf("Body[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return DiffArticleRevisionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DiffArticleRevisionsResponseMultiError is an error wrapping multiple
+// validation errors returned by DiffArticleRevisionsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type DiffArticleRevisionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DiffArticleRevisionsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DiffArticleRevisionsResponseMultiError) AllErrors() []error { return m }
+
+// DiffArticleRevisionsResponseValidationError is the validation error returned
+// by DiffArticleRevisionsResponse.Validate if the designated constraints
+// aren't met.
+type DiffArticleRevisionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DiffArticleRevisionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DiffArticleRevisionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DiffArticleRevisionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DiffArticleRevisionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DiffArticleRevisionsResponseValidationError) ErrorName() string {
+	return "DiffArticleRevisionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DiffArticleRevisionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDiffArticleRevisionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DiffArticleRevisionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DiffArticleRevisionsResponseValidationError{}
+
+// Validate checks the field values on Tag with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Tag) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Tag with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in TagMultiError, or nil if none found.
+func (m *Tag) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Tag) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for ArticleCount
+
+	if len(errors) > 0 {
+		return TagMultiError(errors)
+	}
+
+	return nil
+}
+
+// TagMultiError is an error wrapping multiple validation errors returned by
+// Tag.ValidateAll() if the designated constraints aren't met.
+type TagMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TagMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TagMultiError) AllErrors() []error { return m }
+
+// TagValidationError is the validation error returned by Tag.Validate if the
+// designated constraints aren't met.
+type TagValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TagValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TagValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TagValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TagValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TagValidationError) ErrorName() string { return "TagValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TagValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTag.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TagValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TagValidationError{}
+
+// Validate checks the field values on ListTagsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListTagsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTagsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTagsRequestMultiError, or nil if none found.
+func (m *ListTagsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTagsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetOffset() < 0 {
+		err := ListTagsRequestValidationError{
+			field:  "Offset",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetLimit() < 0 {
+		err := ListTagsRequestValidationError{
+			field:  "Limit",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetSearch()) > 64 {
+		err := ListTagsRequestValidationError{
+			field:  "Search",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListTagsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTagsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListTagsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListTagsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTagsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTagsRequestMultiError) AllErrors() []error { return m }
+
+// ListTagsRequestValidationError is the validation error returned by
+// ListTagsRequest.Validate if the designated constraints aren't met.
+type ListTagsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTagsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTagsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTagsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTagsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTagsRequestValidationError) ErrorName() string { return "ListTagsRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListTagsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTagsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTagsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTagsRequestValidationError{}
+
+// Validate checks the field values on ListTagsResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListTagsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTagsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTagsResponseMultiError, or nil if none found.
+func (m *ListTagsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTagsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTags() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListTagsResponseValidationError{
+						field:  fmt.Sprintf("Tags[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListTagsResponseValidationError{
+						field:  fmt.Sprintf("Tags[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListTagsResponseValidationError{
+					field:  fmt.Sprintf("Tags[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListTagsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTagsResponseMultiError is an error wrapping multiple validation errors
+// returned by ListTagsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListTagsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTagsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTagsResponseMultiError) AllErrors() []error { return m }
+
+// ListTagsResponseValidationError is the validation error returned by
+// ListTagsResponse.Validate if the designated constraints aren't met.
+type ListTagsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTagsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTagsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTagsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTagsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTagsResponseValidationError) ErrorName() string { return "ListTagsResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListTagsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTagsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTagsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTagsResponseValidationError{}
+
+// Validate checks the field values on SearchArticlesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchArticlesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchArticlesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchArticlesRequestMultiError, or nil if none found.
+func (m *SearchArticlesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchArticlesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetQuery()); l < 1 || l > 500 {
+		err := SearchArticlesRequestValidationError{
+			field:  "Query",
+			reason: "value length must be between 1 and 500 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetLanguage()) > 32 {
+		err := SearchArticlesRequestValidationError{
+			field:  "Language",
+			reason: "value length must be at most 32 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_SearchArticlesRequest_Language_Pattern.MatchString(m.GetLanguage()) {
+		err := SearchArticlesRequestValidationError{
+			field:  "Language",
+			reason: "value does not match regex pattern \"^[a-z_]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetOffset() < 0 {
+		err := SearchArticlesRequestValidationError{
+			field:  "Offset",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetLimit() < 0 {
+		err := SearchArticlesRequestValidationError{
+			field:  "Limit",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := ArticleStatus_name[int32(m.GetStatus())]; !ok {
+		err := SearchArticlesRequestValidationError{
+			field:  "Status",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SearchArticlesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchArticlesRequestMultiError is an error wrapping multiple validation
+// errors returned by SearchArticlesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SearchArticlesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchArticlesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchArticlesRequestMultiError) AllErrors() []error { return m }
+
+// SearchArticlesRequestValidationError is the validation error returned by
+// SearchArticlesRequest.Validate if the designated constraints aren't met.
+type SearchArticlesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchArticlesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchArticlesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchArticlesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchArticlesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchArticlesRequestValidationError) ErrorName() string {
+	return "SearchArticlesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchArticlesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchArticlesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchArticlesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchArticlesRequestValidationError{}
+
+var _SearchArticlesRequest_Language_Pattern = regexp.MustCompile("^[a-z_]*$")
+
+// Validate checks the field values on SearchArticlesResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchArticlesResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchArticlesResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchArticlesResultMultiError, or nil if none found.
+func (m *SearchArticlesResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchArticlesResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetArticle()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SearchArticlesResultValidationError{
+					field:  "Article",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SearchArticlesResultValidationError{
+					field:  "Article",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetArticle()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SearchArticlesResultValidationError{
+				field:  "Article",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Rank
+
+	// no validation rules for TitleHighlight
+
+	// no validation rules for Snippet
+
+	if len(errors) > 0 {
+		return SearchArticlesResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchArticlesResultMultiError is an error wrapping multiple validation
+// errors returned by SearchArticlesResult.ValidateAll() if the designated
+// constraints aren't met.
+type SearchArticlesResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchArticlesResultMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchArticlesResultMultiError) AllErrors() []error { return m }
+
+// SearchArticlesResultValidationError is the validation error returned by
+// SearchArticlesResult.Validate if the designated constraints aren't met.
+type SearchArticlesResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchArticlesResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchArticlesResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchArticlesResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchArticlesResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchArticlesResultValidationError) ErrorName() string {
+	return "SearchArticlesResultValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchArticlesResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchArticlesResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchArticlesResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchArticlesResultValidationError{}
+
+// Validate checks the field values on SearchArticlesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchArticlesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchArticlesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchArticlesResponseMultiError, or nil if none found.
+func (m *SearchArticlesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchArticlesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchArticlesResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchArticlesResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchArticlesResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SearchArticlesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchArticlesResponseMultiError is an error wrapping multiple validation
+// errors returned by SearchArticlesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SearchArticlesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchArticlesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchArticlesResponseMultiError) AllErrors() []error { return m }
+
+// SearchArticlesResponseValidationError is the validation error returned by
+// SearchArticlesResponse.Validate if the designated constraints aren't met.
+type SearchArticlesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchArticlesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchArticlesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchArticlesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchArticlesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchArticlesResponseValidationError) ErrorName() string {
+	return "SearchArticlesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchArticlesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchArticlesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchArticlesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchArticlesResponseValidationError{}
+
+// Validate checks the field values on RestoreArticleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RestoreArticleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RestoreArticleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RestoreArticleRequestMultiError, or nil if none found.
+func (m *RestoreArticleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RestoreArticleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = RestoreArticleRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetExpectedVersion() < 0 {
+		err := RestoreArticleRequestValidationError{
+			field:  "ExpectedVersion",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RestoreArticleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *RestoreArticleRequest) _validateUuid(uuid string) error {
+	if matched := _article_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// RestoreArticleRequestMultiError is an error wrapping multiple validation
+// errors returned by RestoreArticleRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RestoreArticleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RestoreArticleRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RestoreArticleRequestMultiError) AllErrors() []error { return m }
+
+// RestoreArticleRequestValidationError is the validation error returned by
+// RestoreArticleRequest.Validate if the designated constraints aren't met.
+type RestoreArticleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestoreArticleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestoreArticleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestoreArticleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestoreArticleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestoreArticleRequestValidationError) ErrorName() string {
+	return "RestoreArticleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RestoreArticleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestoreArticleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestoreArticleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestoreArticleRequestValidationError{}
+
+// Validate checks the field values on ListDeletedArticlesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListDeletedArticlesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListDeletedArticlesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListDeletedArticlesRequestMultiError, or nil if none found.
+func (m *ListDeletedArticlesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListDeletedArticlesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetOffset() < 0 {
+		err := ListDeletedArticlesRequestValidationError{
+			field:  "Offset",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetLimit() < 0 {
+		err := ListDeletedArticlesRequestValidationError{
+			field:  "Limit",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListDeletedArticlesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListDeletedArticlesRequestMultiError is an error wrapping multiple
+// validation errors returned by ListDeletedArticlesRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListDeletedArticlesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListDeletedArticlesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListDeletedArticlesRequestMultiError) AllErrors() []error { return m }
+
+// ListDeletedArticlesRequestValidationError is the validation error returned
+// by ListDeletedArticlesRequest.Validate if the designated constraints aren't met.
+type ListDeletedArticlesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListDeletedArticlesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListDeletedArticlesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListDeletedArticlesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListDeletedArticlesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListDeletedArticlesRequestValidationError) ErrorName() string {
+	return "ListDeletedArticlesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListDeletedArticlesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListDeletedArticlesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListDeletedArticlesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListDeletedArticlesRequestValidationError{}
+
+// Validate checks the field values on ListDeletedArticlesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListDeletedArticlesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListDeletedArticlesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListDeletedArticlesResponseMultiError, or nil if none found.
+func (m *ListDeletedArticlesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListDeletedArticlesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetArticles() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListDeletedArticlesResponseValidationError{
+						field:  fmt.Sprintf("Articles[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListDeletedArticlesResponseValidationError{
+						field:  fmt.Sprintf("Articles[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListDeletedArticlesResponseValidationError{
+					field:  fmt.Sprintf("Articles[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListDeletedArticlesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListDeletedArticlesResponseMultiError is an error wrapping multiple
+// validation errors returned by ListDeletedArticlesResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListDeletedArticlesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListDeletedArticlesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListDeletedArticlesResponseMultiError) AllErrors() []error { return m }
+
+// ListDeletedArticlesResponseValidationError is the validation error returned
+// by ListDeletedArticlesResponse.Validate if the designated constraints
+// aren't met.
+type ListDeletedArticlesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListDeletedArticlesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListDeletedArticlesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListDeletedArticlesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListDeletedArticlesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListDeletedArticlesResponseValidationError) ErrorName() string {
+	return "ListDeletedArticlesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListDeletedArticlesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListDeletedArticlesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListDeletedArticlesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListDeletedArticlesResponseValidationError{}
+
+// Validate checks the field values on WatchArticlesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WatchArticlesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchArticlesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchArticlesRequestMultiError, or nil if none found.
+func (m *WatchArticlesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchArticlesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetAfterSequence() < 0 {
+		err := WatchArticlesRequestValidationError{
+			field:  "AfterSequence",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return WatchArticlesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// WatchArticlesRequestMultiError is an error wrapping multiple validation
+// errors returned by WatchArticlesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type WatchArticlesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchArticlesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchArticlesRequestMultiError) AllErrors() []error { return m }
+
+// WatchArticlesRequestValidationError is the validation error returned by
+// WatchArticlesRequest.Validate if the designated constraints aren't met.
+type WatchArticlesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchArticlesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchArticlesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchArticlesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchArticlesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchArticlesRequestValidationError) ErrorName() string {
+	return "WatchArticlesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WatchArticlesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchArticlesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchArticlesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchArticlesRequestValidationError{}
+
+// Validate checks the field values on ArticleEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ArticleEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ArticleEvent with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ArticleEventMultiError, or
+// nil if none found.
+func (m *ArticleEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ArticleEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Sequence
+
+	// no validation rules for Type
+
+	if all {
+		switch v := interface{}(m.GetArticle()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ArticleEventValidationError{
+					field:  "Article",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ArticleEventValidationError{
+					field:  "Article",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetArticle()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ArticleEventValidationError{
+				field:  "Article",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreateTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ArticleEventValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ArticleEventValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreateTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ArticleEventValidationError{
+				field:  "CreateTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ArticleEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// ArticleEventMultiError is an error wrapping multiple validation errors
+// returned by ArticleEvent.ValidateAll() if the designated constraints aren't met.
+type ArticleEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ArticleEventMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ArticleEventMultiError) AllErrors() []error { return m }
+
+// ArticleEventValidationError is the validation error returned by
+// ArticleEvent.Validate if the designated constraints aren't met.
+type ArticleEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ArticleEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ArticleEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ArticleEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ArticleEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ArticleEventValidationError) ErrorName() string { return "ArticleEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ArticleEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sArticleEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ArticleEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ArticleEventValidationError{}
+
+// Validate checks the field values on GetArticleByIdResponse_Author with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetArticleByIdResponse_Author) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetArticleByIdResponse_Author with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetArticleByIdResponse_AuthorMultiError, or nil if none found.
+func (m *GetArticleByIdResponse_Author) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetArticleByIdResponse_Author) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Fullname
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for UpdatedAt
+
+	if all {
+		switch v := interface{}(m.GetCreateTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetArticleByIdResponse_AuthorValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetArticleByIdResponse_AuthorValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreateTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetArticleByIdResponse_AuthorValidationError{
+				field:  "CreateTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdateTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetArticleByIdResponse_AuthorValidationError{
+					field:  "UpdateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetArticleByIdResponse_AuthorValidationError{
+					field:  "UpdateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdateTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetArticleByIdResponse_AuthorValidationError{
+				field:  "UpdateTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetArticleByIdResponse_AuthorMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetArticleByIdResponse_AuthorMultiError is an error wrapping multiple
+// validation errors returned by GetArticleByIdResponse_Author.ValidateAll()
+// if the designated constraints aren't met.
+type GetArticleByIdResponse_AuthorMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetArticleByIdResponse_AuthorMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetArticleByIdResponse_AuthorMultiError) AllErrors() []error { return m }
+
+// GetArticleByIdResponse_AuthorValidationError is the validation error
+// returned by GetArticleByIdResponse_Author.Validate if the designated
+// constraints aren't met.
+type GetArticleByIdResponse_AuthorValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetArticleByIdResponse_AuthorValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetArticleByIdResponse_AuthorValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetArticleByIdResponse_AuthorValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetArticleByIdResponse_AuthorValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetArticleByIdResponse_AuthorValidationError) ErrorName() string {
+	return "GetArticleByIdResponse_AuthorValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetArticleByIdResponse_AuthorValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetArticleByIdResponse_Author.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetArticleByIdResponse_AuthorValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetArticleByIdResponse_AuthorValidationError{}
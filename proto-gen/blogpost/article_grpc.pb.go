@@ -28,7 +28,35 @@ type ArticleServiceClient interface {
 	UpdateArticle(ctx context.Context, in *UpdateArticleRequest, opts ...grpc.CallOption) (*Article, error)
 	DeleteArticle(ctx context.Context, in *DeleteArticleRequest, opts ...grpc.CallOption) (*Article, error)
 	GetArticleList(ctx context.Context, in *GetArticleListRequest, opts ...grpc.CallOption) (*GetArticleListResponse, error)
+	// GetArticleById and GetArticleBySlug report unpublished articles as
+	// not found to callers without the editor role, unless they are users
+	// with the author role linked to the article's author.
 	GetArticleById(ctx context.Context, in *GetArticleByIdRequest, opts ...grpc.CallOption) (*GetArticleByIdResponse, error)
+	// GetArticleBySlug also resolves slugs the article had before its
+	// title changed; compare the returned slug to detect a redirect.
+	GetArticleBySlug(ctx context.Context, in *GetArticleBySlugRequest, opts ...grpc.CallOption) (*GetArticleByIdResponse, error)
+	PublishArticle(ctx context.Context, in *PublishArticleRequest, opts ...grpc.CallOption) (*Article, error)
+	UnpublishArticle(ctx context.Context, in *UnpublishArticleRequest, opts ...grpc.CallOption) (*Article, error)
+	ArchiveArticle(ctx context.Context, in *ArchiveArticleRequest, opts ...grpc.CallOption) (*Article, error)
+	// ListArticleRevisions, GetArticleRevision and DiffArticleRevisions
+	// report unpublished and deleted articles as not found to the same
+	// callers as GetArticleById.
+	ListArticleRevisions(ctx context.Context, in *ListArticleRevisionsRequest, opts ...grpc.CallOption) (*ListArticleRevisionsResponse, error)
+	GetArticleRevision(ctx context.Context, in *GetArticleRevisionRequest, opts ...grpc.CallOption) (*ArticleRevision, error)
+	RestoreArticleRevision(ctx context.Context, in *RestoreArticleRevisionRequest, opts ...grpc.CallOption) (*Article, error)
+	// DiffArticleRevisions fails with FailedPrecondition when the
+	// revisions differ in too many lines to be diffed.
+	DiffArticleRevisions(ctx context.Context, in *DiffArticleRevisionsRequest, opts ...grpc.CallOption) (*DiffArticleRevisionsResponse, error)
+	ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (*ListTagsResponse, error)
+	SearchArticles(ctx context.Context, in *SearchArticlesRequest, opts ...grpc.CallOption) (*SearchArticlesResponse, error)
+	// RestoreArticle undoes DeleteArticle. Deleted articles are purged for
+	// good once the retention period has passed.
+	RestoreArticle(ctx context.Context, in *RestoreArticleRequest, opts ...grpc.CallOption) (*Article, error)
+	ListDeletedArticles(ctx context.Context, in *ListDeletedArticlesRequest, opts ...grpc.CallOption) (*ListDeletedArticlesResponse, error)
+	// WatchArticles streams the changes made to articles, on any replica,
+	// in the order they were committed. Editors only, as deleted articles
+	// are included.
+	WatchArticles(ctx context.Context, in *WatchArticlesRequest, opts ...grpc.CallOption) (ArticleService_WatchArticlesClient, error)
 }
 
 type articleServiceClient struct {
@@ -93,6 +121,146 @@ func (c *articleServiceClient) GetArticleById(ctx context.Context, in *GetArticl
 	return out, nil
 }
 
+func (c *articleServiceClient) GetArticleBySlug(ctx context.Context, in *GetArticleBySlugRequest, opts ...grpc.CallOption) (*GetArticleByIdResponse, error) {
+	out := new(GetArticleByIdResponse)
+	err := c.cc.Invoke(ctx, "/ArticleService/GetArticleBySlug", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) PublishArticle(ctx context.Context, in *PublishArticleRequest, opts ...grpc.CallOption) (*Article, error) {
+	out := new(Article)
+	err := c.cc.Invoke(ctx, "/ArticleService/PublishArticle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) UnpublishArticle(ctx context.Context, in *UnpublishArticleRequest, opts ...grpc.CallOption) (*Article, error) {
+	out := new(Article)
+	err := c.cc.Invoke(ctx, "/ArticleService/UnpublishArticle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) ArchiveArticle(ctx context.Context, in *ArchiveArticleRequest, opts ...grpc.CallOption) (*Article, error) {
+	out := new(Article)
+	err := c.cc.Invoke(ctx, "/ArticleService/ArchiveArticle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) ListArticleRevisions(ctx context.Context, in *ListArticleRevisionsRequest, opts ...grpc.CallOption) (*ListArticleRevisionsResponse, error) {
+	out := new(ListArticleRevisionsResponse)
+	err := c.cc.Invoke(ctx, "/ArticleService/ListArticleRevisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) GetArticleRevision(ctx context.Context, in *GetArticleRevisionRequest, opts ...grpc.CallOption) (*ArticleRevision, error) {
+	out := new(ArticleRevision)
+	err := c.cc.Invoke(ctx, "/ArticleService/GetArticleRevision", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) RestoreArticleRevision(ctx context.Context, in *RestoreArticleRevisionRequest, opts ...grpc.CallOption) (*Article, error) {
+	out := new(Article)
+	err := c.cc.Invoke(ctx, "/ArticleService/RestoreArticleRevision", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) DiffArticleRevisions(ctx context.Context, in *DiffArticleRevisionsRequest, opts ...grpc.CallOption) (*DiffArticleRevisionsResponse, error) {
+	out := new(DiffArticleRevisionsResponse)
+	err := c.cc.Invoke(ctx, "/ArticleService/DiffArticleRevisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (*ListTagsResponse, error) {
+	out := new(ListTagsResponse)
+	err := c.cc.Invoke(ctx, "/ArticleService/ListTags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) SearchArticles(ctx context.Context, in *SearchArticlesRequest, opts ...grpc.CallOption) (*SearchArticlesResponse, error) {
+	out := new(SearchArticlesResponse)
+	err := c.cc.Invoke(ctx, "/ArticleService/SearchArticles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) RestoreArticle(ctx context.Context, in *RestoreArticleRequest, opts ...grpc.CallOption) (*Article, error) {
+	out := new(Article)
+	err := c.cc.Invoke(ctx, "/ArticleService/RestoreArticle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) ListDeletedArticles(ctx context.Context, in *ListDeletedArticlesRequest, opts ...grpc.CallOption) (*ListDeletedArticlesResponse, error) {
+	out := new(ListDeletedArticlesResponse)
+	err := c.cc.Invoke(ctx, "/ArticleService/ListDeletedArticles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) WatchArticles(ctx context.Context, in *WatchArticlesRequest, opts ...grpc.CallOption) (ArticleService_WatchArticlesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ArticleService_ServiceDesc.Streams[0], "/ArticleService/WatchArticles", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &articleServiceWatchArticlesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ArticleService_WatchArticlesClient interface {
+	Recv() (*ArticleEvent, error)
+	grpc.ClientStream
+}
+
+type articleServiceWatchArticlesClient struct {
+	grpc.ClientStream
+}
+
+func (x *articleServiceWatchArticlesClient) Recv() (*ArticleEvent, error) {
+	m := new(ArticleEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // ArticleServiceServer is the server API for ArticleService service.
 // All implementations must embed UnimplementedArticleServiceServer
 // for forward compatibility
@@ -103,7 +271,35 @@ type ArticleServiceServer interface {
 	UpdateArticle(context.Context, *UpdateArticleRequest) (*Article, error)
 	DeleteArticle(context.Context, *DeleteArticleRequest) (*Article, error)
 	GetArticleList(context.Context, *GetArticleListRequest) (*GetArticleListResponse, error)
+	// GetArticleById and GetArticleBySlug report unpublished articles as
+	// not found to callers without the editor role, unless they are users
+	// with the author role linked to the article's author.
 	GetArticleById(context.Context, *GetArticleByIdRequest) (*GetArticleByIdResponse, error)
+	// GetArticleBySlug also resolves slugs the article had before its
+	// title changed; compare the returned slug to detect a redirect.
+	GetArticleBySlug(context.Context, *GetArticleBySlugRequest) (*GetArticleByIdResponse, error)
+	PublishArticle(context.Context, *PublishArticleRequest) (*Article, error)
+	UnpublishArticle(context.Context, *UnpublishArticleRequest) (*Article, error)
+	ArchiveArticle(context.Context, *ArchiveArticleRequest) (*Article, error)
+	// ListArticleRevisions, GetArticleRevision and DiffArticleRevisions
+	// report unpublished and deleted articles as not found to the same
+	// callers as GetArticleById.
+	ListArticleRevisions(context.Context, *ListArticleRevisionsRequest) (*ListArticleRevisionsResponse, error)
+	GetArticleRevision(context.Context, *GetArticleRevisionRequest) (*ArticleRevision, error)
+	RestoreArticleRevision(context.Context, *RestoreArticleRevisionRequest) (*Article, error)
+	// DiffArticleRevisions fails with FailedPrecondition when the
+	// revisions differ in too many lines to be diffed.
+	DiffArticleRevisions(context.Context, *DiffArticleRevisionsRequest) (*DiffArticleRevisionsResponse, error)
+	ListTags(context.Context, *ListTagsRequest) (*ListTagsResponse, error)
+	SearchArticles(context.Context, *SearchArticlesRequest) (*SearchArticlesResponse, error)
+	// RestoreArticle undoes DeleteArticle. Deleted articles are purged for
+	// good once the retention period has passed.
+	RestoreArticle(context.Context, *RestoreArticleRequest) (*Article, error)
+	ListDeletedArticles(context.Context, *ListDeletedArticlesRequest) (*ListDeletedArticlesResponse, error)
+	// WatchArticles streams the changes made to articles, on any replica,
+	// in the order they were committed. Editors only, as deleted articles
+	// are included.
+	WatchArticles(*WatchArticlesRequest, ArticleService_WatchArticlesServer) error
 	mustEmbedUnimplementedArticleServiceServer()
 }
 
@@ -129,6 +325,45 @@ func (UnimplementedArticleServiceServer) GetArticleList(context.Context, *GetArt
 func (UnimplementedArticleServiceServer) GetArticleById(context.Context, *GetArticleByIdRequest) (*GetArticleByIdResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetArticleById not implemented")
 }
+func (UnimplementedArticleServiceServer) GetArticleBySlug(context.Context, *GetArticleBySlugRequest) (*GetArticleByIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetArticleBySlug not implemented")
+}
+func (UnimplementedArticleServiceServer) PublishArticle(context.Context, *PublishArticleRequest) (*Article, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishArticle not implemented")
+}
+func (UnimplementedArticleServiceServer) UnpublishArticle(context.Context, *UnpublishArticleRequest) (*Article, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpublishArticle not implemented")
+}
+func (UnimplementedArticleServiceServer) ArchiveArticle(context.Context, *ArchiveArticleRequest) (*Article, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveArticle not implemented")
+}
+func (UnimplementedArticleServiceServer) ListArticleRevisions(context.Context, *ListArticleRevisionsRequest) (*ListArticleRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListArticleRevisions not implemented")
+}
+func (UnimplementedArticleServiceServer) GetArticleRevision(context.Context, *GetArticleRevisionRequest) (*ArticleRevision, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetArticleRevision not implemented")
+}
+func (UnimplementedArticleServiceServer) RestoreArticleRevision(context.Context, *RestoreArticleRevisionRequest) (*Article, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreArticleRevision not implemented")
+}
+func (UnimplementedArticleServiceServer) DiffArticleRevisions(context.Context, *DiffArticleRevisionsRequest) (*DiffArticleRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffArticleRevisions not implemented")
+}
+func (UnimplementedArticleServiceServer) ListTags(context.Context, *ListTagsRequest) (*ListTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTags not implemented")
+}
+func (UnimplementedArticleServiceServer) SearchArticles(context.Context, *SearchArticlesRequest) (*SearchArticlesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchArticles not implemented")
+}
+func (UnimplementedArticleServiceServer) RestoreArticle(context.Context, *RestoreArticleRequest) (*Article, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreArticle not implemented")
+}
+func (UnimplementedArticleServiceServer) ListDeletedArticles(context.Context, *ListDeletedArticlesRequest) (*ListDeletedArticlesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeletedArticles not implemented")
+}
+func (UnimplementedArticleServiceServer) WatchArticles(*WatchArticlesRequest, ArticleService_WatchArticlesServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchArticles not implemented")
+}
 func (UnimplementedArticleServiceServer) mustEmbedUnimplementedArticleServiceServer() {}
 
 // UnsafeArticleServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -250,6 +485,243 @@ func _ArticleService_GetArticleById_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ArticleService_GetArticleBySlug_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetArticleBySlugRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).GetArticleBySlug(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ArticleService/GetArticleBySlug",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).GetArticleBySlug(ctx, req.(*GetArticleBySlugRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_PublishArticle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishArticleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).PublishArticle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ArticleService/PublishArticle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).PublishArticle(ctx, req.(*PublishArticleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_UnpublishArticle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpublishArticleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).UnpublishArticle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ArticleService/UnpublishArticle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).UnpublishArticle(ctx, req.(*UnpublishArticleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_ArchiveArticle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveArticleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).ArchiveArticle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ArticleService/ArchiveArticle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).ArchiveArticle(ctx, req.(*ArchiveArticleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_ListArticleRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListArticleRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).ListArticleRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ArticleService/ListArticleRevisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).ListArticleRevisions(ctx, req.(*ListArticleRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_GetArticleRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetArticleRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).GetArticleRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ArticleService/GetArticleRevision",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).GetArticleRevision(ctx, req.(*GetArticleRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_RestoreArticleRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreArticleRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).RestoreArticleRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ArticleService/RestoreArticleRevision",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).RestoreArticleRevision(ctx, req.(*RestoreArticleRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_DiffArticleRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffArticleRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).DiffArticleRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ArticleService/DiffArticleRevisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).DiffArticleRevisions(ctx, req.(*DiffArticleRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_ListTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).ListTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ArticleService/ListTags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).ListTags(ctx, req.(*ListTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_SearchArticles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchArticlesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).SearchArticles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ArticleService/SearchArticles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).SearchArticles(ctx, req.(*SearchArticlesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_RestoreArticle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreArticleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).RestoreArticle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ArticleService/RestoreArticle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).RestoreArticle(ctx, req.(*RestoreArticleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_ListDeletedArticles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeletedArticlesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).ListDeletedArticles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ArticleService/ListDeletedArticles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).ListDeletedArticles(ctx, req.(*ListDeletedArticlesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_WatchArticles_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchArticlesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ArticleServiceServer).WatchArticles(m, &articleServiceWatchArticlesServer{stream})
+}
+
+type ArticleService_WatchArticlesServer interface {
+	Send(*ArticleEvent) error
+	grpc.ServerStream
+}
+
+type articleServiceWatchArticlesServer struct {
+	grpc.ServerStream
+}
+
+func (x *articleServiceWatchArticlesServer) Send(m *ArticleEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // ArticleService_ServiceDesc is the grpc.ServiceDesc for ArticleService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -281,7 +753,61 @@ var ArticleService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetArticleById",
 			Handler:    _ArticleService_GetArticleById_Handler,
 		},
+		{
+			MethodName: "GetArticleBySlug",
+			Handler:    _ArticleService_GetArticleBySlug_Handler,
+		},
+		{
+			MethodName: "PublishArticle",
+			Handler:    _ArticleService_PublishArticle_Handler,
+		},
+		{
+			MethodName: "UnpublishArticle",
+			Handler:    _ArticleService_UnpublishArticle_Handler,
+		},
+		{
+			MethodName: "ArchiveArticle",
+			Handler:    _ArticleService_ArchiveArticle_Handler,
+		},
+		{
+			MethodName: "ListArticleRevisions",
+			Handler:    _ArticleService_ListArticleRevisions_Handler,
+		},
+		{
+			MethodName: "GetArticleRevision",
+			Handler:    _ArticleService_GetArticleRevision_Handler,
+		},
+		{
+			MethodName: "RestoreArticleRevision",
+			Handler:    _ArticleService_RestoreArticleRevision_Handler,
+		},
+		{
+			MethodName: "DiffArticleRevisions",
+			Handler:    _ArticleService_DiffArticleRevisions_Handler,
+		},
+		{
+			MethodName: "ListTags",
+			Handler:    _ArticleService_ListTags_Handler,
+		},
+		{
+			MethodName: "SearchArticles",
+			Handler:    _ArticleService_SearchArticles_Handler,
+		},
+		{
+			MethodName: "RestoreArticle",
+			Handler:    _ArticleService_RestoreArticle_Handler,
+		},
+		{
+			MethodName: "ListDeletedArticles",
+			Handler:    _ArticleService_ListDeletedArticles_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchArticles",
+			Handler:       _ArticleService_WatchArticles_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "protos/article.proto",
 }
@@ -0,0 +1,3078 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: protos/author.proto
+
+package blogpost
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// define the regex for a UUID once up-front
+var _author_uuidPattern = regexp.MustCompile("^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$")
+
+// Validate checks the field values on CreateAuthorRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateAuthorRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateAuthorRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateAuthorRequestMultiError, or nil if none found.
+func (m *CreateAuthorRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateAuthorRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetFullname() != "" {
+
+		if l := utf8.RuneCountInString(m.GetFullname()); l < 2 || l > 255 {
+			err := CreateAuthorRequestValidationError{
+				field:  "Fullname",
+				reason: "value length must be between 2 and 255 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetProfile()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateAuthorRequestValidationError{
+					field:  "Profile",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateAuthorRequestValidationError{
+					field:  "Profile",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProfile()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateAuthorRequestValidationError{
+				field:  "Profile",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateAuthorRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateAuthorRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateAuthorRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateAuthorRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateAuthorRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateAuthorRequestMultiError) AllErrors() []error { return m }
+
+// CreateAuthorRequestValidationError is the validation error returned by
+// CreateAuthorRequest.Validate if the designated constraints aren't met.
+type CreateAuthorRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateAuthorRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateAuthorRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateAuthorRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateAuthorRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateAuthorRequestValidationError) ErrorName() string {
+	return "CreateAuthorRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateAuthorRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateAuthorRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateAuthorRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateAuthorRequestValidationError{}
+
+// Validate checks the field values on UpdateAuthorRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateAuthorRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateAuthorRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateAuthorRequestMultiError, or nil if none found.
+func (m *UpdateAuthorRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateAuthorRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = UpdateAuthorRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetFullname() != "" {
+
+		if l := utf8.RuneCountInString(m.GetFullname()); l < 2 || l > 255 {
+			err := UpdateAuthorRequestValidationError{
+				field:  "Fullname",
+				reason: "value length must be between 2 and 255 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetExpectedVersion() < 0 {
+		err := UpdateAuthorRequestValidationError{
+			field:  "ExpectedVersion",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdateMask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateAuthorRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateAuthorRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdateMask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateAuthorRequestValidationError{
+				field:  "UpdateMask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetProfile()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateAuthorRequestValidationError{
+					field:  "Profile",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateAuthorRequestValidationError{
+					field:  "Profile",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProfile()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateAuthorRequestValidationError{
+				field:  "Profile",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateAuthorRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *UpdateAuthorRequest) _validateUuid(uuid string) error {
+	if matched := _author_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// UpdateAuthorRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateAuthorRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateAuthorRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateAuthorRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateAuthorRequestMultiError) AllErrors() []error { return m }
+
+// UpdateAuthorRequestValidationError is the validation error returned by
+// UpdateAuthorRequest.Validate if the designated constraints aren't met.
+type UpdateAuthorRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateAuthorRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateAuthorRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateAuthorRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateAuthorRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateAuthorRequestValidationError) ErrorName() string {
+	return "UpdateAuthorRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateAuthorRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateAuthorRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateAuthorRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateAuthorRequestValidationError{}
+
+// Validate checks the field values on AuthorProfile with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AuthorProfile) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuthorProfile with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AuthorProfileMultiError, or
+// nil if none found.
+func (m *AuthorProfile) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuthorProfile) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetGivenName()) > 100 {
+		err := AuthorProfileValidationError{
+			field:  "GivenName",
+			reason: "value length must be at most 100 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetFamilyName()) > 100 {
+		err := AuthorProfileValidationError{
+			field:  "FamilyName",
+			reason: "value length must be at most 100 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetMiddleName()) > 100 {
+		err := AuthorProfileValidationError{
+			field:  "MiddleName",
+			reason: "value length must be at most 100 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDisplayName()) > 255 {
+		err := AuthorProfileValidationError{
+			field:  "DisplayName",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetBio()) > 5000 {
+		err := AuthorProfileValidationError{
+			field:  "Bio",
+			reason: "value length must be at most 5000 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetAvatarUrl() != "" {
+
+		if uri, err := url.Parse(m.GetAvatarUrl()); err != nil {
+			err = AuthorProfileValidationError{
+				field:  "AvatarUrl",
+				reason: "value must be a valid URI",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else if !uri.IsAbs() {
+			err := AuthorProfileValidationError{
+				field:  "AvatarUrl",
+				reason: "value must be absolute",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetWebsite() != "" {
+
+		if uri, err := url.Parse(m.GetWebsite()); err != nil {
+			err = AuthorProfileValidationError{
+				field:  "Website",
+				reason: "value must be a valid URI",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else if !uri.IsAbs() {
+			err := AuthorProfileValidationError{
+				field:  "Website",
+				reason: "value must be absolute",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(m.GetSocialLinks()) > 20 {
+		err := AuthorProfileValidationError{
+			field:  "SocialLinks",
+			reason: "value must contain no more than 20 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetSocialLinks() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AuthorProfileValidationError{
+						field:  fmt.Sprintf("SocialLinks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AuthorProfileValidationError{
+						field:  fmt.Sprintf("SocialLinks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AuthorProfileValidationError{
+					field:  fmt.Sprintf("SocialLinks[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AuthorProfileMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuthorProfileMultiError is an error wrapping multiple validation errors
+// returned by AuthorProfile.ValidateAll() if the designated constraints
+// aren't met.
+type AuthorProfileMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuthorProfileMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuthorProfileMultiError) AllErrors() []error { return m }
+
+// AuthorProfileValidationError is the validation error returned by
+// AuthorProfile.Validate if the designated constraints aren't met.
+type AuthorProfileValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuthorProfileValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuthorProfileValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuthorProfileValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuthorProfileValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuthorProfileValidationError) ErrorName() string { return "AuthorProfileValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AuthorProfileValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuthorProfile.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuthorProfileValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuthorProfileValidationError{}
+
+// Validate checks the field values on SocialLink with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SocialLink) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SocialLink with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SocialLinkMultiError, or
+// nil if none found.
+func (m *SocialLink) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SocialLink) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetNetwork()); l < 1 || l > 32 {
+		err := SocialLinkValidationError{
+			field:  "Network",
+			reason: "value length must be between 1 and 32 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if uri, err := url.Parse(m.GetUrl()); err != nil {
+		err = SocialLinkValidationError{
+			field:  "Url",
+			reason: "value must be a valid URI",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	} else if !uri.IsAbs() {
+		err := SocialLinkValidationError{
+			field:  "Url",
+			reason: "value must be absolute",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SocialLinkMultiError(errors)
+	}
+
+	return nil
+}
+
+// SocialLinkMultiError is an error wrapping multiple validation errors
+// returned by SocialLink.ValidateAll() if the designated constraints aren't met.
+type SocialLinkMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SocialLinkMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SocialLinkMultiError) AllErrors() []error { return m }
+
+// SocialLinkValidationError is the validation error returned by
+// SocialLink.Validate if the designated constraints aren't met.
+type SocialLinkValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SocialLinkValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SocialLinkValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SocialLinkValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SocialLinkValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SocialLinkValidationError) ErrorName() string { return "SocialLinkValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SocialLinkValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSocialLink.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SocialLinkValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SocialLinkValidationError{}
+
+// Validate checks the field values on DeleteAuthorRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteAuthorRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteAuthorRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteAuthorRequestMultiError, or nil if none found.
+func (m *DeleteAuthorRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteAuthorRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = DeleteAuthorRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetExpectedVersion() < 0 {
+		err := DeleteAuthorRequestValidationError{
+			field:  "ExpectedVersion",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := DeleteAuthorPolicy_name[int32(m.GetPolicy())]; !ok {
+		err := DeleteAuthorRequestValidationError{
+			field:  "Policy",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetReassignTo() != "" {
+
+		if err := m._validateUuid(m.GetReassignTo()); err != nil {
+			err = DeleteAuthorRequestValidationError{
+				field:  "ReassignTo",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return DeleteAuthorRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *DeleteAuthorRequest) _validateUuid(uuid string) error {
+	if matched := _author_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// DeleteAuthorRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteAuthorRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteAuthorRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteAuthorRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteAuthorRequestMultiError) AllErrors() []error { return m }
+
+// DeleteAuthorRequestValidationError is the validation error returned by
+// DeleteAuthorRequest.Validate if the designated constraints aren't met.
+type DeleteAuthorRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteAuthorRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteAuthorRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteAuthorRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteAuthorRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteAuthorRequestValidationError) ErrorName() string {
+	return "DeleteAuthorRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteAuthorRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteAuthorRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteAuthorRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteAuthorRequestValidationError{}
+
+// Validate checks the field values on GetAuthorListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAuthorListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAuthorListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAuthorListRequestMultiError, or nil if none found.
+func (m *GetAuthorListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAuthorListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetOffset() < 0 {
+		err := GetAuthorListRequestValidationError{
+			field:  "Offset",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetLimit() < 0 {
+		err := GetAuthorListRequestValidationError{
+			field:  "Limit",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetSearch()) > 255 {
+		err := GetAuthorListRequestValidationError{
+			field:  "Search",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	// no validation rules for IncludeTotalCount
+
+	if len(errors) > 0 {
+		return GetAuthorListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAuthorListRequestMultiError is an error wrapping multiple validation
+// errors returned by GetAuthorListRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetAuthorListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAuthorListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAuthorListRequestMultiError) AllErrors() []error { return m }
+
+// GetAuthorListRequestValidationError is the validation error returned by
+// GetAuthorListRequest.Validate if the designated constraints aren't met.
+type GetAuthorListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAuthorListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAuthorListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAuthorListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAuthorListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAuthorListRequestValidationError) ErrorName() string {
+	return "GetAuthorListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAuthorListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAuthorListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAuthorListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAuthorListRequestValidationError{}
+
+// Validate checks the field values on GetAuthorByIdRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAuthorByIdRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAuthorByIdRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAuthorByIdRequestMultiError, or nil if none found.
+func (m *GetAuthorByIdRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAuthorByIdRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = GetAuthorByIdRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetAuthorByIdRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetAuthorByIdRequest) _validateUuid(uuid string) error {
+	if matched := _author_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetAuthorByIdRequestMultiError is an error wrapping multiple validation
+// errors returned by GetAuthorByIdRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetAuthorByIdRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAuthorByIdRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAuthorByIdRequestMultiError) AllErrors() []error { return m }
+
+// GetAuthorByIdRequestValidationError is the validation error returned by
+// GetAuthorByIdRequest.Validate if the designated constraints aren't met.
+type GetAuthorByIdRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAuthorByIdRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAuthorByIdRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAuthorByIdRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAuthorByIdRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAuthorByIdRequestValidationError) ErrorName() string {
+	return "GetAuthorByIdRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAuthorByIdRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAuthorByIdRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAuthorByIdRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAuthorByIdRequestValidationError{}
+
+// Validate checks the field values on Author with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Author) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Author with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in AuthorMultiError, or nil if none found.
+func (m *Author) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Author) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Fullname
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for UpdatedAt
+
+	// no validation rules for Version
+
+	// no validation rules for DeletedAt
+
+	if all {
+		switch v := interface{}(m.GetProfile()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AuthorValidationError{
+					field:  "Profile",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AuthorValidationError{
+					field:  "Profile",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProfile()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AuthorValidationError{
+				field:  "Profile",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreateTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AuthorValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AuthorValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreateTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AuthorValidationError{
+				field:  "CreateTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdateTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AuthorValidationError{
+					field:  "UpdateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AuthorValidationError{
+					field:  "UpdateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdateTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AuthorValidationError{
+				field:  "UpdateTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDeleteTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AuthorValidationError{
+					field:  "DeleteTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AuthorValidationError{
+					field:  "DeleteTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDeleteTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AuthorValidationError{
+				field:  "DeleteTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AuthorMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuthorMultiError is an error wrapping multiple validation errors returned by
+// Author.ValidateAll() if the designated constraints aren't met.
+type AuthorMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuthorMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuthorMultiError) AllErrors() []error { return m }
+
+// AuthorValidationError is the validation error returned by Author.Validate if
+// the designated constraints aren't met.
+type AuthorValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuthorValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuthorValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuthorValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuthorValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuthorValidationError) ErrorName() string { return "AuthorValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AuthorValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuthor.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuthorValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuthorValidationError{}
+
+// Validate checks the field values on GetAuthorListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAuthorListResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAuthorListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAuthorListResponseMultiError, or nil if none found.
+func (m *GetAuthorListResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAuthorListResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetAuthors() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetAuthorListResponseValidationError{
+						field:  fmt.Sprintf("Authors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetAuthorListResponseValidationError{
+						field:  fmt.Sprintf("Authors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetAuthorListResponseValidationError{
+					field:  fmt.Sprintf("Authors[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	// no validation rules for TotalCount
+
+	if len(errors) > 0 {
+		return GetAuthorListResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAuthorListResponseMultiError is an error wrapping multiple validation
+// errors returned by GetAuthorListResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetAuthorListResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAuthorListResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAuthorListResponseMultiError) AllErrors() []error { return m }
+
+// GetAuthorListResponseValidationError is the validation error returned by
+// GetAuthorListResponse.Validate if the designated constraints aren't met.
+type GetAuthorListResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAuthorListResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAuthorListResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAuthorListResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAuthorListResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAuthorListResponseValidationError) ErrorName() string {
+	return "GetAuthorListResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAuthorListResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAuthorListResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAuthorListResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAuthorListResponseValidationError{}
+
+// Validate checks the field values on GetAuthorByIdResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAuthorByIdResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAuthorByIdResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAuthorByIdResponseMultiError, or nil if none found.
+func (m *GetAuthorByIdResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAuthorByIdResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Fullname
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for UpdatedAt
+
+	// no validation rules for Version
+
+	if all {
+		switch v := interface{}(m.GetProfile()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetAuthorByIdResponseValidationError{
+					field:  "Profile",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetAuthorByIdResponseValidationError{
+					field:  "Profile",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProfile()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetAuthorByIdResponseValidationError{
+				field:  "Profile",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreateTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetAuthorByIdResponseValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetAuthorByIdResponseValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreateTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetAuthorByIdResponseValidationError{
+				field:  "CreateTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdateTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetAuthorByIdResponseValidationError{
+					field:  "UpdateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetAuthorByIdResponseValidationError{
+					field:  "UpdateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdateTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetAuthorByIdResponseValidationError{
+				field:  "UpdateTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetAuthorByIdResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAuthorByIdResponseMultiError is an error wrapping multiple validation
+// errors returned by GetAuthorByIdResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetAuthorByIdResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAuthorByIdResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAuthorByIdResponseMultiError) AllErrors() []error { return m }
+
+// GetAuthorByIdResponseValidationError is the validation error returned by
+// GetAuthorByIdResponse.Validate if the designated constraints aren't met.
+type GetAuthorByIdResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAuthorByIdResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAuthorByIdResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAuthorByIdResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAuthorByIdResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAuthorByIdResponseValidationError) ErrorName() string {
+	return "GetAuthorByIdResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAuthorByIdResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAuthorByIdResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAuthorByIdResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAuthorByIdResponseValidationError{}
+
+// Validate checks the field values on RestoreAuthorRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RestoreAuthorRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RestoreAuthorRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RestoreAuthorRequestMultiError, or nil if none found.
+func (m *RestoreAuthorRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RestoreAuthorRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = RestoreAuthorRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetExpectedVersion() < 0 {
+		err := RestoreAuthorRequestValidationError{
+			field:  "ExpectedVersion",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RestoreAuthorRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *RestoreAuthorRequest) _validateUuid(uuid string) error {
+	if matched := _author_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// RestoreAuthorRequestMultiError is an error wrapping multiple validation
+// errors returned by RestoreAuthorRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RestoreAuthorRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RestoreAuthorRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RestoreAuthorRequestMultiError) AllErrors() []error { return m }
+
+// RestoreAuthorRequestValidationError is the validation error returned by
+// RestoreAuthorRequest.Validate if the designated constraints aren't met.
+type RestoreAuthorRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestoreAuthorRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestoreAuthorRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestoreAuthorRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestoreAuthorRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestoreAuthorRequestValidationError) ErrorName() string {
+	return "RestoreAuthorRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RestoreAuthorRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestoreAuthorRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestoreAuthorRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestoreAuthorRequestValidationError{}
+
+// Validate checks the field values on ListDeletedAuthorsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListDeletedAuthorsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListDeletedAuthorsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListDeletedAuthorsRequestMultiError, or nil if none found.
+func (m *ListDeletedAuthorsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListDeletedAuthorsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetOffset() < 0 {
+		err := ListDeletedAuthorsRequestValidationError{
+			field:  "Offset",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetLimit() < 0 {
+		err := ListDeletedAuthorsRequestValidationError{
+			field:  "Limit",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListDeletedAuthorsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListDeletedAuthorsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListDeletedAuthorsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ListDeletedAuthorsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListDeletedAuthorsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListDeletedAuthorsRequestMultiError) AllErrors() []error { return m }
+
+// ListDeletedAuthorsRequestValidationError is the validation error returned by
+// ListDeletedAuthorsRequest.Validate if the designated constraints aren't met.
+type ListDeletedAuthorsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListDeletedAuthorsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListDeletedAuthorsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListDeletedAuthorsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListDeletedAuthorsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListDeletedAuthorsRequestValidationError) ErrorName() string {
+	return "ListDeletedAuthorsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListDeletedAuthorsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListDeletedAuthorsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListDeletedAuthorsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListDeletedAuthorsRequestValidationError{}
+
+// Validate checks the field values on ListDeletedAuthorsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListDeletedAuthorsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListDeletedAuthorsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListDeletedAuthorsResponseMultiError, or nil if none found.
+func (m *ListDeletedAuthorsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListDeletedAuthorsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetAuthors() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListDeletedAuthorsResponseValidationError{
+						field:  fmt.Sprintf("Authors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListDeletedAuthorsResponseValidationError{
+						field:  fmt.Sprintf("Authors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListDeletedAuthorsResponseValidationError{
+					field:  fmt.Sprintf("Authors[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListDeletedAuthorsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListDeletedAuthorsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListDeletedAuthorsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListDeletedAuthorsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListDeletedAuthorsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListDeletedAuthorsResponseMultiError) AllErrors() []error { return m }
+
+// ListDeletedAuthorsResponseValidationError is the validation error returned
+// by ListDeletedAuthorsResponse.Validate if the designated constraints aren't met.
+type ListDeletedAuthorsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListDeletedAuthorsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListDeletedAuthorsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListDeletedAuthorsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListDeletedAuthorsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListDeletedAuthorsResponseValidationError) ErrorName() string {
+	return "ListDeletedAuthorsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListDeletedAuthorsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListDeletedAuthorsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListDeletedAuthorsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListDeletedAuthorsResponseValidationError{}
+
+// Validate checks the field values on UserAuthorLink with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UserAuthorLink) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserAuthorLink with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UserAuthorLinkMultiError,
+// or nil if none found.
+func (m *UserAuthorLink) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserAuthorLink) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for AuthorId
+
+	if all {
+		switch v := interface{}(m.GetCreateTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserAuthorLinkValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserAuthorLinkValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreateTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserAuthorLinkValidationError{
+				field:  "CreateTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UserAuthorLinkMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserAuthorLinkMultiError is an error wrapping multiple validation errors
+// returned by UserAuthorLink.ValidateAll() if the designated constraints
+// aren't met.
+type UserAuthorLinkMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserAuthorLinkMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserAuthorLinkMultiError) AllErrors() []error { return m }
+
+// UserAuthorLinkValidationError is the validation error returned by
+// UserAuthorLink.Validate if the designated constraints aren't met.
+type UserAuthorLinkValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserAuthorLinkValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserAuthorLinkValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserAuthorLinkValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserAuthorLinkValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserAuthorLinkValidationError) ErrorName() string { return "UserAuthorLinkValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UserAuthorLinkValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserAuthorLink.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserAuthorLinkValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserAuthorLinkValidationError{}
+
+// Validate checks the field values on LinkUserToAuthorRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *LinkUserToAuthorRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LinkUserToAuthorRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LinkUserToAuthorRequestMultiError, or nil if none found.
+func (m *LinkUserToAuthorRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LinkUserToAuthorRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetUserId()); l < 1 || l > 255 {
+		err := LinkUserToAuthorRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetAuthorId()); err != nil {
+		err = LinkUserToAuthorRequestValidationError{
+			field:  "AuthorId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return LinkUserToAuthorRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *LinkUserToAuthorRequest) _validateUuid(uuid string) error {
+	if matched := _author_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// LinkUserToAuthorRequestMultiError is an error wrapping multiple validation
+// errors returned by LinkUserToAuthorRequest.ValidateAll() if the designated
+// constraints aren't met.
+type LinkUserToAuthorRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LinkUserToAuthorRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LinkUserToAuthorRequestMultiError) AllErrors() []error { return m }
+
+// LinkUserToAuthorRequestValidationError is the validation error returned by
+// LinkUserToAuthorRequest.Validate if the designated constraints aren't met.
+type LinkUserToAuthorRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LinkUserToAuthorRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LinkUserToAuthorRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LinkUserToAuthorRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LinkUserToAuthorRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LinkUserToAuthorRequestValidationError) ErrorName() string {
+	return "LinkUserToAuthorRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e LinkUserToAuthorRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLinkUserToAuthorRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LinkUserToAuthorRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LinkUserToAuthorRequestValidationError{}
+
+// Validate checks the field values on UnlinkUserFromAuthorRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UnlinkUserFromAuthorRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnlinkUserFromAuthorRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnlinkUserFromAuthorRequestMultiError, or nil if none found.
+func (m *UnlinkUserFromAuthorRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnlinkUserFromAuthorRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetUserId()); l < 1 || l > 255 {
+		err := UnlinkUserFromAuthorRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetAuthorId()); err != nil {
+		err = UnlinkUserFromAuthorRequestValidationError{
+			field:  "AuthorId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UnlinkUserFromAuthorRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *UnlinkUserFromAuthorRequest) _validateUuid(uuid string) error {
+	if matched := _author_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// UnlinkUserFromAuthorRequestMultiError is an error wrapping multiple
+// validation errors returned by UnlinkUserFromAuthorRequest.ValidateAll() if
+// the designated constraints aren't met.
+type UnlinkUserFromAuthorRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnlinkUserFromAuthorRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnlinkUserFromAuthorRequestMultiError) AllErrors() []error { return m }
+
+// UnlinkUserFromAuthorRequestValidationError is the validation error returned
+// by UnlinkUserFromAuthorRequest.Validate if the designated constraints
+// aren't met.
+type UnlinkUserFromAuthorRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnlinkUserFromAuthorRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnlinkUserFromAuthorRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnlinkUserFromAuthorRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnlinkUserFromAuthorRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnlinkUserFromAuthorRequestValidationError) ErrorName() string {
+	return "UnlinkUserFromAuthorRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UnlinkUserFromAuthorRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnlinkUserFromAuthorRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnlinkUserFromAuthorRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnlinkUserFromAuthorRequestValidationError{}
+
+// Validate checks the field values on ListUserAuthorsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListUserAuthorsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListUserAuthorsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListUserAuthorsRequestMultiError, or nil if none found.
+func (m *ListUserAuthorsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListUserAuthorsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetUserId()) > 255 {
+		err := ListUserAuthorsRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListUserAuthorsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListUserAuthorsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListUserAuthorsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListUserAuthorsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListUserAuthorsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListUserAuthorsRequestMultiError) AllErrors() []error { return m }
+
+// ListUserAuthorsRequestValidationError is the validation error returned by
+// ListUserAuthorsRequest.Validate if the designated constraints aren't met.
+type ListUserAuthorsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListUserAuthorsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListUserAuthorsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListUserAuthorsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListUserAuthorsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListUserAuthorsRequestValidationError) ErrorName() string {
+	return "ListUserAuthorsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListUserAuthorsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListUserAuthorsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListUserAuthorsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListUserAuthorsRequestValidationError{}
+
+// Validate checks the field values on ListUserAuthorsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListUserAuthorsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListUserAuthorsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListUserAuthorsResponseMultiError, or nil if none found.
+func (m *ListUserAuthorsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListUserAuthorsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetLinks() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListUserAuthorsResponseValidationError{
+						field:  fmt.Sprintf("Links[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListUserAuthorsResponseValidationError{
+						field:  fmt.Sprintf("Links[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListUserAuthorsResponseValidationError{
+					field:  fmt.Sprintf("Links[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListUserAuthorsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListUserAuthorsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListUserAuthorsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListUserAuthorsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListUserAuthorsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListUserAuthorsResponseMultiError) AllErrors() []error { return m }
+
+// ListUserAuthorsResponseValidationError is the validation error returned by
+// ListUserAuthorsResponse.Validate if the designated constraints aren't met.
+type ListUserAuthorsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListUserAuthorsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListUserAuthorsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListUserAuthorsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListUserAuthorsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListUserAuthorsResponseValidationError) ErrorName() string {
+	return "ListUserAuthorsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListUserAuthorsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListUserAuthorsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListUserAuthorsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListUserAuthorsResponseValidationError{}
+
+// Validate checks the field values on WatchAuthorsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WatchAuthorsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchAuthorsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchAuthorsRequestMultiError, or nil if none found.
+func (m *WatchAuthorsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchAuthorsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetAfterSequence() < 0 {
+		err := WatchAuthorsRequestValidationError{
+			field:  "AfterSequence",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return WatchAuthorsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// WatchAuthorsRequestMultiError is an error wrapping multiple validation
+// errors returned by WatchAuthorsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type WatchAuthorsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchAuthorsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchAuthorsRequestMultiError) AllErrors() []error { return m }
+
+// WatchAuthorsRequestValidationError is the validation error returned by
+// WatchAuthorsRequest.Validate if the designated constraints aren't met.
+type WatchAuthorsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchAuthorsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchAuthorsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchAuthorsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchAuthorsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchAuthorsRequestValidationError) ErrorName() string {
+	return "WatchAuthorsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WatchAuthorsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchAuthorsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchAuthorsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchAuthorsRequestValidationError{}
+
+// Validate checks the field values on AuthorEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AuthorEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuthorEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AuthorEventMultiError, or
+// nil if none found.
+func (m *AuthorEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuthorEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Sequence
+
+	// no validation rules for Type
+
+	if all {
+		switch v := interface{}(m.GetAuthor()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AuthorEventValidationError{
+					field:  "Author",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AuthorEventValidationError{
+					field:  "Author",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAuthor()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AuthorEventValidationError{
+				field:  "Author",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreateTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AuthorEventValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AuthorEventValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreateTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AuthorEventValidationError{
+				field:  "CreateTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AuthorEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuthorEventMultiError is an error wrapping multiple validation errors
+// returned by AuthorEvent.ValidateAll() if the designated constraints aren't met.
+type AuthorEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuthorEventMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuthorEventMultiError) AllErrors() []error { return m }
+
+// AuthorEventValidationError is the validation error returned by
+// AuthorEvent.Validate if the designated constraints aren't met.
+type AuthorEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuthorEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuthorEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuthorEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuthorEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuthorEventValidationError) ErrorName() string { return "AuthorEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AuthorEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuthorEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuthorEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuthorEventValidationError{}
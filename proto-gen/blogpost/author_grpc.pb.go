@@ -26,9 +26,28 @@ type AuthorServiceClient interface {
 	SayHello(ctx context.Context, in *HelloRequest, opts ...grpc.CallOption) (*HelloReply, error)
 	CreateAuthor(ctx context.Context, in *CreateAuthorRequest, opts ...grpc.CallOption) (*Author, error)
 	UpdateAuthor(ctx context.Context, in *UpdateAuthorRequest, opts ...grpc.CallOption) (*Author, error)
+	// DeleteAuthor returns the author as it was before the deletion. The
+	// number of articles the policy deleted or reassigned is sent in the
+	// x-articles-affected response header.
 	DeleteAuthor(ctx context.Context, in *DeleteAuthorRequest, opts ...grpc.CallOption) (*Author, error)
 	GetAuthorList(ctx context.Context, in *GetAuthorListRequest, opts ...grpc.CallOption) (*GetAuthorListResponse, error)
 	GetAuthorById(ctx context.Context, in *GetAuthorByIdRequest, opts ...grpc.CallOption) (*GetAuthorByIdResponse, error)
+	// RestoreAuthor undoes DeleteAuthor. Deleted authors are purged for
+	// good once the retention period has passed and none of their
+	// articles are left.
+	RestoreAuthor(ctx context.Context, in *RestoreAuthorRequest, opts ...grpc.CallOption) (*Author, error)
+	ListDeletedAuthors(ctx context.Context, in *ListDeletedAuthorsRequest, opts ...grpc.CallOption) (*ListDeletedAuthorsResponse, error)
+	// LinkUserToAuthor lets a user with the author role write the
+	// articles of the author. Admins only.
+	LinkUserToAuthor(ctx context.Context, in *LinkUserToAuthorRequest, opts ...grpc.CallOption) (*UserAuthorLink, error)
+	UnlinkUserFromAuthor(ctx context.Context, in *UnlinkUserFromAuthorRequest, opts ...grpc.CallOption) (*UserAuthorLink, error)
+	// ListUserAuthors lists the authors a user is linked to. Users may
+	// list their own links, admins anyone's.
+	ListUserAuthors(ctx context.Context, in *ListUserAuthorsRequest, opts ...grpc.CallOption) (*ListUserAuthorsResponse, error)
+	// WatchAuthors streams the changes made to authors, on any replica, in
+	// the order they were committed. Editors only, as deleted authors are
+	// included.
+	WatchAuthors(ctx context.Context, in *WatchAuthorsRequest, opts ...grpc.CallOption) (AuthorService_WatchAuthorsClient, error)
 }
 
 type authorServiceClient struct {
@@ -93,6 +112,83 @@ func (c *authorServiceClient) GetAuthorById(ctx context.Context, in *GetAuthorBy
 	return out, nil
 }
 
+func (c *authorServiceClient) RestoreAuthor(ctx context.Context, in *RestoreAuthorRequest, opts ...grpc.CallOption) (*Author, error) {
+	out := new(Author)
+	err := c.cc.Invoke(ctx, "/AuthorService/RestoreAuthor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authorServiceClient) ListDeletedAuthors(ctx context.Context, in *ListDeletedAuthorsRequest, opts ...grpc.CallOption) (*ListDeletedAuthorsResponse, error) {
+	out := new(ListDeletedAuthorsResponse)
+	err := c.cc.Invoke(ctx, "/AuthorService/ListDeletedAuthors", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authorServiceClient) LinkUserToAuthor(ctx context.Context, in *LinkUserToAuthorRequest, opts ...grpc.CallOption) (*UserAuthorLink, error) {
+	out := new(UserAuthorLink)
+	err := c.cc.Invoke(ctx, "/AuthorService/LinkUserToAuthor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authorServiceClient) UnlinkUserFromAuthor(ctx context.Context, in *UnlinkUserFromAuthorRequest, opts ...grpc.CallOption) (*UserAuthorLink, error) {
+	out := new(UserAuthorLink)
+	err := c.cc.Invoke(ctx, "/AuthorService/UnlinkUserFromAuthor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authorServiceClient) ListUserAuthors(ctx context.Context, in *ListUserAuthorsRequest, opts ...grpc.CallOption) (*ListUserAuthorsResponse, error) {
+	out := new(ListUserAuthorsResponse)
+	err := c.cc.Invoke(ctx, "/AuthorService/ListUserAuthors", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authorServiceClient) WatchAuthors(ctx context.Context, in *WatchAuthorsRequest, opts ...grpc.CallOption) (AuthorService_WatchAuthorsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &AuthorService_ServiceDesc.Streams[0], "/AuthorService/WatchAuthors", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &authorServiceWatchAuthorsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AuthorService_WatchAuthorsClient interface {
+	Recv() (*AuthorEvent, error)
+	grpc.ClientStream
+}
+
+type authorServiceWatchAuthorsClient struct {
+	grpc.ClientStream
+}
+
+func (x *authorServiceWatchAuthorsClient) Recv() (*AuthorEvent, error) {
+	m := new(AuthorEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // AuthorServiceServer is the server API for AuthorService service.
 // All implementations must embed UnimplementedAuthorServiceServer
 // for forward compatibility
@@ -101,9 +197,28 @@ type AuthorServiceServer interface {
 	SayHello(context.Context, *HelloRequest) (*HelloReply, error)
 	CreateAuthor(context.Context, *CreateAuthorRequest) (*Author, error)
 	UpdateAuthor(context.Context, *UpdateAuthorRequest) (*Author, error)
+	// DeleteAuthor returns the author as it was before the deletion. The
+	// number of articles the policy deleted or reassigned is sent in the
+	// x-articles-affected response header.
 	DeleteAuthor(context.Context, *DeleteAuthorRequest) (*Author, error)
 	GetAuthorList(context.Context, *GetAuthorListRequest) (*GetAuthorListResponse, error)
 	GetAuthorById(context.Context, *GetAuthorByIdRequest) (*GetAuthorByIdResponse, error)
+	// RestoreAuthor undoes DeleteAuthor. Deleted authors are purged for
+	// good once the retention period has passed and none of their
+	// articles are left.
+	RestoreAuthor(context.Context, *RestoreAuthorRequest) (*Author, error)
+	ListDeletedAuthors(context.Context, *ListDeletedAuthorsRequest) (*ListDeletedAuthorsResponse, error)
+	// LinkUserToAuthor lets a user with the author role write the
+	// articles of the author. Admins only.
+	LinkUserToAuthor(context.Context, *LinkUserToAuthorRequest) (*UserAuthorLink, error)
+	UnlinkUserFromAuthor(context.Context, *UnlinkUserFromAuthorRequest) (*UserAuthorLink, error)
+	// ListUserAuthors lists the authors a user is linked to. Users may
+	// list their own links, admins anyone's.
+	ListUserAuthors(context.Context, *ListUserAuthorsRequest) (*ListUserAuthorsResponse, error)
+	// WatchAuthors streams the changes made to authors, on any replica, in
+	// the order they were committed. Editors only, as deleted authors are
+	// included.
+	WatchAuthors(*WatchAuthorsRequest, AuthorService_WatchAuthorsServer) error
 	mustEmbedUnimplementedAuthorServiceServer()
 }
 
@@ -129,6 +244,24 @@ func (UnimplementedAuthorServiceServer) GetAuthorList(context.Context, *GetAutho
 func (UnimplementedAuthorServiceServer) GetAuthorById(context.Context, *GetAuthorByIdRequest) (*GetAuthorByIdResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAuthorById not implemented")
 }
+func (UnimplementedAuthorServiceServer) RestoreAuthor(context.Context, *RestoreAuthorRequest) (*Author, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreAuthor not implemented")
+}
+func (UnimplementedAuthorServiceServer) ListDeletedAuthors(context.Context, *ListDeletedAuthorsRequest) (*ListDeletedAuthorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeletedAuthors not implemented")
+}
+func (UnimplementedAuthorServiceServer) LinkUserToAuthor(context.Context, *LinkUserToAuthorRequest) (*UserAuthorLink, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LinkUserToAuthor not implemented")
+}
+func (UnimplementedAuthorServiceServer) UnlinkUserFromAuthor(context.Context, *UnlinkUserFromAuthorRequest) (*UserAuthorLink, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlinkUserFromAuthor not implemented")
+}
+func (UnimplementedAuthorServiceServer) ListUserAuthors(context.Context, *ListUserAuthorsRequest) (*ListUserAuthorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserAuthors not implemented")
+}
+func (UnimplementedAuthorServiceServer) WatchAuthors(*WatchAuthorsRequest, AuthorService_WatchAuthorsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchAuthors not implemented")
+}
 func (UnimplementedAuthorServiceServer) mustEmbedUnimplementedAuthorServiceServer() {}
 
 // UnsafeAuthorServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -250,6 +383,117 @@ func _AuthorService_GetAuthorById_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuthorService_RestoreAuthor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreAuthorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthorServiceServer).RestoreAuthor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AuthorService/RestoreAuthor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthorServiceServer).RestoreAuthor(ctx, req.(*RestoreAuthorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthorService_ListDeletedAuthors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeletedAuthorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthorServiceServer).ListDeletedAuthors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AuthorService/ListDeletedAuthors",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthorServiceServer).ListDeletedAuthors(ctx, req.(*ListDeletedAuthorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthorService_LinkUserToAuthor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LinkUserToAuthorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthorServiceServer).LinkUserToAuthor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AuthorService/LinkUserToAuthor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthorServiceServer).LinkUserToAuthor(ctx, req.(*LinkUserToAuthorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthorService_UnlinkUserFromAuthor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlinkUserFromAuthorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthorServiceServer).UnlinkUserFromAuthor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AuthorService/UnlinkUserFromAuthor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthorServiceServer).UnlinkUserFromAuthor(ctx, req.(*UnlinkUserFromAuthorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthorService_ListUserAuthors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserAuthorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthorServiceServer).ListUserAuthors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AuthorService/ListUserAuthors",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthorServiceServer).ListUserAuthors(ctx, req.(*ListUserAuthorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthorService_WatchAuthors_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchAuthorsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AuthorServiceServer).WatchAuthors(m, &authorServiceWatchAuthorsServer{stream})
+}
+
+type AuthorService_WatchAuthorsServer interface {
+	Send(*AuthorEvent) error
+	grpc.ServerStream
+}
+
+type authorServiceWatchAuthorsServer struct {
+	grpc.ServerStream
+}
+
+func (x *authorServiceWatchAuthorsServer) Send(m *AuthorEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // AuthorService_ServiceDesc is the grpc.ServiceDesc for AuthorService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -281,7 +525,33 @@ var AuthorService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAuthorById",
 			Handler:    _AuthorService_GetAuthorById_Handler,
 		},
+		{
+			MethodName: "RestoreAuthor",
+			Handler:    _AuthorService_RestoreAuthor_Handler,
+		},
+		{
+			MethodName: "ListDeletedAuthors",
+			Handler:    _AuthorService_ListDeletedAuthors_Handler,
+		},
+		{
+			MethodName: "LinkUserToAuthor",
+			Handler:    _AuthorService_LinkUserToAuthor_Handler,
+		},
+		{
+			MethodName: "UnlinkUserFromAuthor",
+			Handler:    _AuthorService_UnlinkUserFromAuthor_Handler,
+		},
+		{
+			MethodName: "ListUserAuthors",
+			Handler:    _AuthorService_ListUserAuthors_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchAuthors",
+			Handler:       _AuthorService_WatchAuthors_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "protos/author.proto",
 }
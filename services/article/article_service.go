@@ -2,26 +2,39 @@ package article
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/google/uuid"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	articleproto "github.com/uacademy/blogpost/article_service/proto-gen/blogpost"
+	"github.com/uacademy/blogpost/article_service/config"
+	"github.com/uacademy/blogpost/article_service/services/audit"
+	"github.com/uacademy/blogpost/article_service/services/auth"
+	"github.com/uacademy/blogpost/article_service/services/grpcerr"
+	"github.com/uacademy/blogpost/article_service/services/policy"
+	"github.com/uacademy/blogpost/article_service/services/watch"
 	"github.com/uacademy/blogpost/article_service/storage"
 )
 
 // We define a articleService struct that implements the server interface.
 
 type articleService struct {
-	stg storage.StorageI
+	cfg      config.Config
+	stg      storage.StorageI
+	policy   policy.Policy
+	notifier storage.ChangeNotifier
 	articleproto.UnimplementedArticleServiceServer
 }
 
 // NewArticleService ...
-func NewArticleService(stg storage.StorageI) *articleService {
+func NewArticleService(cfg config.Config, stg storage.StorageI, notifier storage.ChangeNotifier) *articleService {
 	return &articleService{
-		stg: stg,
+		cfg:      cfg,
+		stg:      stg,
+		policy:   policy.New(cfg),
+		notifier: notifier,
 	}
 }
 
@@ -34,79 +47,491 @@ func (s *articleService) SayHello(ctx context.Context, in *articleproto.HelloReq
 func (s *articleService) CreateArticle(ctx context.Context, req *articleproto.CreateArticleRequest) (*articleproto.Article, error) {
 	id := uuid.New()
 
-	err := s.stg.AddArticle(id.String(), req)
+	var article *articleproto.GetArticleByIdResponse
+	err := s.stg.WithTx(ctx, func(tx storage.StorageI) error {
+		err := s.policy.WriteArticle(ctx, tx, "create article", "", req.AuthorId)
+		if err != nil {
+			return fmt.Errorf("s.policy.WriteArticle: %w", err)
+		}
+
+		err = tx.AddArticle(ctx, id.String(), req, editor(ctx))
+		if err != nil {
+			return fmt.Errorf("s.stg.AddArticle: %w", err)
+		}
+
+		article, err = tx.ReadArticleById(ctx, id.String())
+		if err != nil {
+			return fmt.Errorf("s.stg.ReadArticleById: %w", err)
+		}
+
+		err = audit.Record(ctx, tx, audit.EntityArticle, id.String(), nil, toArticle(article))
+		if err != nil {
+			return fmt.Errorf("audit.Record: %w", err)
+		}
+		return nil
+	})
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "s.stg.AddArticle: %s", err.Error())
+		return nil, grpcerr.FromStorage(err, "s.stg.WithTx")
 	}
 
-	article, err := s.stg.ReadArticleById(id.String())
+	return toArticle(article), nil
+}
+
+func (s *articleService) UpdateArticle(ctx context.Context, req *articleproto.UpdateArticleRequest) (*articleproto.Article, error) {
+	req.EditedBy = editor(ctx)
+
+	var article *articleproto.GetArticleByIdResponse
+	err := s.stg.WithTx(ctx, func(tx storage.StorageI) error {
+		err := s.authorize(ctx, tx, "update article", req.Id)
+		if err != nil {
+			return err
+		}
+
+		before, err := tx.ReadArticleById(ctx, req.Id)
+		if err != nil {
+			return fmt.Errorf("s.stg.ReadArticleById: %w", err)
+		}
+
+		err = tx.UpdateArticle(ctx, req)
+		if err != nil {
+			return fmt.Errorf("s.stg.UpdateArticle: %w", err)
+		}
+
+		article, err = tx.ReadArticleById(ctx, req.Id)
+		if err != nil {
+			return fmt.Errorf("s.stg.ReadArticleById: %w", err)
+		}
+
+		err = audit.Record(ctx, tx, audit.EntityArticle, req.Id, toArticle(before), toArticle(article))
+		if err != nil {
+			return fmt.Errorf("audit.Record: %w", err)
+		}
+		return nil
+	})
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "s.stg.ReadArticleById: %s", err.Error())
+		return nil, grpcerr.FromStorage(err, "s.stg.WithTx")
 	}
 
-	return &articleproto.Article{
-		Id: article.Id,
-		Content: article.Content,
-		AuthorId:  article.Author.Id,
-		CreatedAt: article.CreatedAt,
-		UpdatedAt: article.UpdatedAt,
-	}, nil
+	return toArticle(article), nil
 }
 
-func (s *articleService) UpdateArticle(ctx context.Context, req *articleproto.UpdateArticleRequest) (*articleproto.Article, error) {
-	err := s.stg.UpdateArticle(req)
+func (s *articleService) DeleteArticle(ctx context.Context, req *articleproto.DeleteArticleRequest) (*articleproto.Article, error) {
+	var article *articleproto.GetArticleByIdResponse
+	err := s.stg.WithTx(ctx, func(tx storage.StorageI) error {
+		err := s.authorize(ctx, tx, "delete article", req.Id)
+		if err != nil {
+			return err
+		}
+
+		article, err = tx.ReadArticleById(ctx, req.Id)
+		if err != nil {
+			return fmt.Errorf("s.stg.ReadArticleById: %w", err)
+		}
+
+		err = tx.DeleteArticle(ctx, article.Id, req.ExpectedVersion)
+		if err != nil {
+			return fmt.Errorf("s.stg.DeleteArticle: %w", err)
+		}
+
+		err = audit.Record(ctx, tx, audit.EntityArticle, article.Id, toArticle(article), nil)
+		if err != nil {
+			return fmt.Errorf("audit.Record: %w", err)
+		}
+		return nil
+	})
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "s.stg.UpdateArticle: %s", err.Error())
+		return nil, grpcerr.FromStorage(err, "s.stg.WithTx")
 	}
 
-	article, err := s.stg.ReadArticleById(req.Id)
+	return toArticle(article), nil
+}
+
+func (s *articleService) GetArticleList(ctx context.Context, req *articleproto.GetArticleListRequest) (*articleproto.GetArticleListResponse, error) {
+	var err error
+	req.Status, err = s.readableStatus(ctx, req.Status, req.AuthorId)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "s.stg.ReadArticleById: %s", err.Error())
+		return nil, grpcerr.FromStorage(err, "s.readableStatus")
 	}
 
-	return &articleproto.Article{
-		Id: article.Id,
-		Content: article.Content,
-		AuthorId:  article.Author.Id,
-		CreatedAt: article.CreatedAt,
-		UpdatedAt: article.UpdatedAt,
-	}, nil
+	req.Limit = s.cfg.PageSize(req.Limit)
+
+	res, err := s.stg.ReadListArticle(ctx, req)
+	if err != nil {
+		return nil, grpcerr.FromStorage(err, "s.stg.ReadListArticle")
+	}
+
+	return res, nil
 }
 
-func (s *articleService) DeleteArticle(ctx context.Context, req *articleproto.DeleteArticleRequest) (*articleproto.Article, error) {
-	article, err := s.stg.ReadArticleById(req.Id)
+func (s *articleService) GetArticleById(ctx context.Context, req *articleproto.GetArticleByIdRequest) (*articleproto.GetArticleByIdResponse, error) {
+	article, err := s.stg.ReadArticleById(ctx, req.Id)
+	if err != nil {
+		return nil, grpcerr.FromStorage(err, "s.stg.ReadArticleById")
+	}
+	err = s.readable(ctx, article.Status, article.Author.Id, nil, req.Id)
+	if err != nil {
+		return nil, grpcerr.FromStorage(err, "s.readable")
+	}
+
+	return article, nil
+}
+
+func (s *articleService) GetArticleBySlug(ctx context.Context, req *articleproto.GetArticleBySlugRequest) (*articleproto.GetArticleByIdResponse, error) {
+	article, err := s.stg.ReadArticleBySlug(ctx, req.Slug)
+	if err != nil {
+		return nil, grpcerr.FromStorage(err, "s.stg.ReadArticleBySlug")
+	}
+	err = s.readable(ctx, article.Status, article.Author.Id, nil, req.Slug)
+	if err != nil {
+		return nil, grpcerr.FromStorage(err, "s.readable")
+	}
+
+	return article, nil
+}
+
+// editor returns the caller recorded as edited_by on new revisions, ""
+// for anonymous callers. The edited_by of requests is ignored, so edits
+// can not be attributed to someone else.
+func editor(ctx context.Context) string {
+	if id, ok := auth.FromContext(ctx); ok {
+		return id.Subject
+	}
+	return ""
+}
+
+// readableStatus limits a status filter of callers who may not read
+// the unpublished articles of authorId, or of every author when it is
+// empty, to published ones.
+func (s *articleService) readableStatus(ctx context.Context, status articleproto.ArticleStatus, authorId string) (articleproto.ArticleStatus, error) {
+	ok, err := s.policy.CanReadUnpublished(ctx, s.stg, authorId)
+	if err != nil {
+		return status, err
+	}
+	if ok {
+		return status, nil
+	}
+
+	switch status {
+	case articleproto.ArticleStatus_ARTICLE_STATUS_UNSPECIFIED, articleproto.ArticleStatus_ARTICLE_STATUS_PUBLISHED:
+		return articleproto.ArticleStatus_ARTICLE_STATUS_PUBLISHED, nil
+	}
+	return status, storage.PermissionDenied("", "", policy.ReasonMissingRole,
+		"reading unpublished articles requires one of the roles "+policy.RoleEditor+", "+policy.RoleAdmin+
+			", or the "+policy.RoleAuthor+" role and author_id set to a linked author")
+}
+
+// readable reports an article the caller may not read as not found.
+// Deleted articles count as unpublished. name is the id or slug the
+// article was asked for by.
+func (s *articleService) readable(ctx context.Context, status articleproto.ArticleStatus, authorId string, deleteTime *timestamppb.Timestamp, name string) error {
+	if status == articleproto.ArticleStatus_ARTICLE_STATUS_PUBLISHED && deleteTime == nil {
+		return nil
+	}
+
+	ok, err := s.policy.CanReadUnpublished(ctx, s.stg, authorId)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return storage.NotFound("article", name)
+	}
+	return nil
+}
+
+// readableRevisions hides the revisions of articles the caller may not
+// read.
+func (s *articleService) readableRevisions(ctx context.Context, articleId string) error {
+	articles, err := s.stg.ReadArticlesById(ctx, []string{articleId})
+	if err != nil {
+		return err
+	}
+	if len(articles) == 0 {
+		return storage.NotFound("article", articleId)
+	}
+
+	article := articles[0]
+	return s.readable(ctx, article.Status, article.AuthorId, article.DeleteTime, articleId)
+}
+
+func (s *articleService) PublishArticle(ctx context.Context, req *articleproto.PublishArticleRequest) (*articleproto.Article, error) {
+	return s.setStatus(ctx, req.Id, articleproto.ArticleStatus_ARTICLE_STATUS_PUBLISHED)
+}
+
+func (s *articleService) UnpublishArticle(ctx context.Context, req *articleproto.UnpublishArticleRequest) (*articleproto.Article, error) {
+	return s.setStatus(ctx, req.Id, articleproto.ArticleStatus_ARTICLE_STATUS_DRAFT)
+}
+
+func (s *articleService) ArchiveArticle(ctx context.Context, req *articleproto.ArchiveArticleRequest) (*articleproto.Article, error) {
+	return s.setStatus(ctx, req.Id, articleproto.ArticleStatus_ARTICLE_STATUS_ARCHIVED)
+}
+
+func (s *articleService) setStatus(ctx context.Context, id string, articleStatus articleproto.ArticleStatus) (*articleproto.Article, error) {
+	var article *articleproto.GetArticleByIdResponse
+	err := s.stg.WithTx(ctx, func(tx storage.StorageI) error {
+		err := s.authorize(ctx, tx, "change article status", id)
+		if err != nil {
+			return err
+		}
+
+		before, err := tx.ReadArticleById(ctx, id)
+		if err != nil {
+			return fmt.Errorf("s.stg.ReadArticleById: %w", err)
+		}
+
+		err = tx.SetArticleStatus(ctx, id, articleStatus)
+		if err != nil {
+			return fmt.Errorf("s.stg.SetArticleStatus: %w", err)
+		}
+
+		article, err = tx.ReadArticleById(ctx, id)
+		if err != nil {
+			return fmt.Errorf("s.stg.ReadArticleById: %w", err)
+		}
+
+		err = audit.Record(ctx, tx, audit.EntityArticle, id, toArticle(before), toArticle(article))
+		if err != nil {
+			return fmt.Errorf("audit.Record: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, grpcerr.FromStorage(err, "s.stg.WithTx")
+	}
+
+	return toArticle(article), nil
+}
+
+// authorize checks that the caller may change article id, looking up its
+// author in tx.
+func (s *articleService) authorize(ctx context.Context, tx storage.StorageI, action, id string) error {
+	authorId, err := tx.ReadArticleAuthorId(ctx, id)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "s.stg.ReadArticleById: %s", err.Error())
+		return fmt.Errorf("s.stg.ReadArticleAuthorId: %w", err)
 	}
 
-	err = s.stg.DeleteArticle(article.Id)
+	err = s.policy.WriteArticle(ctx, tx, action, id, authorId)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "s.stg.DeleteArticle: %s", err.Error())
+		return fmt.Errorf("s.policy.WriteArticle: %w", err)
 	}
+	return nil
+}
 
+func toArticle(article *articleproto.GetArticleByIdResponse) *articleproto.Article {
 	return &articleproto.Article{
-		Id: article.Id,
-		Content: article.Content,
-		AuthorId:  article.Author.Id,
-		CreatedAt: article.CreatedAt,
-		UpdatedAt: article.UpdatedAt,
+		Id:          article.Id,
+		Content:     article.Content,
+		AuthorId:    article.Author.Id,
+		CreatedAt:   article.CreatedAt,
+		UpdatedAt:   article.UpdatedAt,
+		Status:      article.Status,
+		PublishedAt: article.PublishedAt,
+		Tags:        article.Tags,
+		Slug:        article.Slug,
+		Language:    article.Language,
+		Version:     article.Version,
+		CreateTime:  article.CreateTime,
+		UpdateTime:  article.UpdateTime,
+		PublishTime: article.PublishTime,
+	}
+}
+
+func (s *articleService) ListArticleRevisions(ctx context.Context, req *articleproto.ListArticleRevisionsRequest) (*articleproto.ListArticleRevisionsResponse, error) {
+	err := s.readableRevisions(ctx, req.ArticleId)
+	if err != nil {
+		return nil, grpcerr.FromStorage(err, "s.readableRevisions")
+	}
+
+	res, err := s.stg.ReadListArticleRevision(ctx, req.ArticleId, int(req.Offset), int(s.cfg.PageSize(req.Limit)))
+	if err != nil {
+		return nil, grpcerr.FromStorage(err, "s.stg.ReadListArticleRevision")
+	}
+
+	return res, nil
+}
+
+func (s *articleService) GetArticleRevision(ctx context.Context, req *articleproto.GetArticleRevisionRequest) (*articleproto.ArticleRevision, error) {
+	err := s.readableRevisions(ctx, req.ArticleId)
+	if err != nil {
+		return nil, grpcerr.FromStorage(err, "s.readableRevisions")
+	}
+
+	revision, err := s.stg.ReadArticleRevision(ctx, req.ArticleId, int(req.Revision))
+	if err != nil {
+		return nil, grpcerr.FromStorage(err, "s.stg.ReadArticleRevision")
+	}
+
+	return revision, nil
+}
+
+func (s *articleService) RestoreArticleRevision(ctx context.Context, req *articleproto.RestoreArticleRevisionRequest) (*articleproto.Article, error) {
+	var article *articleproto.GetArticleByIdResponse
+	err := s.stg.WithTx(ctx, func(tx storage.StorageI) error {
+		err := s.authorize(ctx, tx, "restore article revision", req.ArticleId)
+		if err != nil {
+			return err
+		}
+
+		before, err := tx.ReadArticleById(ctx, req.ArticleId)
+		if err != nil {
+			return fmt.Errorf("s.stg.ReadArticleById: %w", err)
+		}
+
+		err = tx.RestoreArticleRevision(ctx, req.ArticleId, int(req.Revision), editor(ctx))
+		if err != nil {
+			return fmt.Errorf("s.stg.RestoreArticleRevision: %w", err)
+		}
+
+		article, err = tx.ReadArticleById(ctx, req.ArticleId)
+		if err != nil {
+			return fmt.Errorf("s.stg.ReadArticleById: %w", err)
+		}
+
+		err = audit.Record(ctx, tx, audit.EntityArticle, req.ArticleId, toArticle(before), toArticle(article))
+		if err != nil {
+			return fmt.Errorf("audit.Record: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, grpcerr.FromStorage(err, "s.stg.WithTx")
+	}
+
+	return toArticle(article), nil
+}
+
+func (s *articleService) DiffArticleRevisions(ctx context.Context, req *articleproto.DiffArticleRevisionsRequest) (*articleproto.DiffArticleRevisionsResponse, error) {
+	err := s.readableRevisions(ctx, req.ArticleId)
+	if err != nil {
+		return nil, grpcerr.FromStorage(err, "s.readableRevisions")
+	}
+
+	from, err := s.stg.ReadArticleRevision(ctx, req.ArticleId, int(req.FromRevision))
+	if err != nil {
+		return nil, grpcerr.FromStorage(err, "s.stg.ReadArticleRevision")
+	}
+
+	to, err := s.stg.ReadArticleRevision(ctx, req.ArticleId, int(req.ToRevision))
+	if err != nil {
+		return nil, grpcerr.FromStorage(err, "s.stg.ReadArticleRevision")
+	}
+
+	title, err := diffLines(from.Content.Title, to.Content.Title)
+	if err != nil {
+		return nil, grpcerr.FromStorage(storage.InvalidState("article", req.ArticleId, "the titles of the revisions differ in too many lines to be diffed"), "diffLines")
+	}
+	body, err := diffLines(from.Content.Body, to.Content.Body)
+	if err != nil {
+		return nil, grpcerr.FromStorage(storage.InvalidState("article", req.ArticleId, "the bodies of the revisions differ in too many lines to be diffed"), "diffLines")
+	}
+
+	return &articleproto.DiffArticleRevisionsResponse{
+		Title: title,
+		Body:  body,
 	}, nil
 }
 
-func (s *articleService) GetArticleList(ctx context.Context, req *articleproto.GetArticleListRequest) (*articleproto.GetArticleListResponse, error) {
-	res, err := s.stg.ReadListArticle(int(req.Offset), int(req.Limit), req.Search)
+func (s *articleService) ListTags(ctx context.Context, req *articleproto.ListTagsRequest) (*articleproto.ListTagsResponse, error) {
+	res, err := s.stg.ReadListTag(ctx, int(req.Offset), int(s.cfg.PageSize(req.Limit)), req.Search)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "s.stg.ReadListArticle: %s", err.Error())
+		return nil, grpcerr.FromStorage(err, "s.stg.ReadListTag")
 	}
 
 	return res, nil
 }
 
-func (s *articleService) GetArticleById(ctx context.Context, req *articleproto.GetArticleByIdRequest) (*articleproto.GetArticleByIdResponse, error) {
-	article, err := s.stg.ReadArticleById(req.Id)
+func (s *articleService) SearchArticles(ctx context.Context, req *articleproto.SearchArticlesRequest) (*articleproto.SearchArticlesResponse, error) {
+	var err error
+	req.Status, err = s.readableStatus(ctx, req.Status, "")
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "s.stg.ReadArticleById: %s", err.Error())
+		return nil, grpcerr.FromStorage(err, "s.readableStatus")
 	}
 
-	return article, nil
+	req.Limit = s.cfg.PageSize(req.Limit)
+
+	res, err := s.stg.SearchArticles(ctx, req)
+	if err != nil {
+		return nil, grpcerr.FromStorage(err, "s.stg.SearchArticles")
+	}
+
+	return res, nil
+}
+
+func (s *articleService) RestoreArticle(ctx context.Context, req *articleproto.RestoreArticleRequest) (*articleproto.Article, error) {
+	var article *articleproto.GetArticleByIdResponse
+	err := s.stg.WithTx(ctx, func(tx storage.StorageI) error {
+		err := s.authorize(ctx, tx, "restore article", req.Id)
+		if err != nil {
+			return err
+		}
+
+		deleted, err := tx.ReadArticlesById(ctx, []string{req.Id})
+		if err != nil {
+			return fmt.Errorf("s.stg.ReadArticlesById: %w", err)
+		}
+
+		err = tx.RestoreArticle(ctx, req.Id, req.ExpectedVersion)
+		if err != nil {
+			return fmt.Errorf("s.stg.RestoreArticle: %w", err)
+		}
+
+		article, err = tx.ReadArticleById(ctx, req.Id)
+		if err != nil {
+			return fmt.Errorf("s.stg.ReadArticleById: %w", err)
+		}
+
+		var before proto.Message
+		if len(deleted) > 0 {
+			before = deleted[0]
+		}
+
+		err = audit.Record(ctx, tx, audit.EntityArticle, req.Id, before, toArticle(article))
+		if err != nil {
+			return fmt.Errorf("audit.Record: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, grpcerr.FromStorage(err, "s.stg.WithTx")
+	}
+
+	return toArticle(article), nil
+}
+
+func (s *articleService) ListDeletedArticles(ctx context.Context, req *articleproto.ListDeletedArticlesRequest) (*articleproto.ListDeletedArticlesResponse, error) {
+	err := s.policy.RequireRole(ctx, "list deleted articles", policy.RoleEditor)
+	if err != nil {
+		return nil, grpcerr.FromStorage(err, "s.policy.RequireRole")
+	}
+
+	req.Limit = s.cfg.PageSize(req.Limit)
+
+	res, err := s.stg.ReadListDeletedArticle(ctx, req)
+	if err != nil {
+		return nil, grpcerr.FromStorage(err, "s.stg.ReadListDeletedArticle")
+	}
+
+	return res, nil
+}
+
+func (s *articleService) WatchArticles(req *articleproto.WatchArticlesRequest, stream articleproto.ArticleService_WatchArticlesServer) error {
+	ctx := stream.Context()
+	err := s.policy.RequireRole(ctx, "watch articles", policy.RoleEditor)
+	if err != nil {
+		return grpcerr.FromStorage(err, "s.policy.RequireRole")
+	}
+
+	return watch.Run(ctx, s.stg, s.notifier, audit.EntityArticle, req.AfterSequence, func(ctx context.Context, afterSequence int64) (int64, error) {
+		events, err := s.stg.ReadListArticleEvent(ctx, afterSequence, watch.BatchSize)
+		if err != nil {
+			return afterSequence, grpcerr.FromStorage(err, "s.stg.ReadListArticleEvent")
+		}
+
+		for _, e := range events {
+			if err := stream.Send(e); err != nil {
+				return afterSequence, err
+			}
+			afterSequence = e.Sequence
+		}
+		return afterSequence, nil
+	})
 }
@@ -0,0 +1,107 @@
+package article
+
+import (
+	"errors"
+	"strings"
+
+	articleproto "github.com/uacademy/blogpost/article_service/proto-gen/blogpost"
+)
+
+// maxDiffCells bounds the size of the table diffLines fills, the product
+// of the line counts left after the common prefix and suffix are
+// stripped. At 4 bytes a cell this is 16 MB.
+const maxDiffCells = 4 << 20
+
+// errDiffTooLarge is returned by diffLines when the texts differ in too
+// many lines to be diffed.
+var errDiffTooLarge = errors.New("texts differ in too many lines")
+
+// diffLines returns a line based diff turning a into b. It walks the
+// longest common subsequence of the two texts, so unchanged lines are
+// reported as equal and everything else as deleted or inserted.
+func diffLines(a, b string) ([]*articleproto.DiffLine, error) {
+	x := splitLines(a)
+	y := splitLines(b)
+
+	// Lines shared at the start and the end need no table.
+	prefix := 0
+	for prefix < len(x) && prefix < len(y) && x[prefix] == y[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(x)-prefix && suffix < len(y)-prefix && x[len(x)-1-suffix] == y[len(y)-1-suffix] {
+		suffix++
+	}
+
+	res := make([]*articleproto.DiffLine, 0, len(x)+len(y)-prefix-suffix)
+	for _, line := range x[:prefix] {
+		res = append(res, &articleproto.DiffLine{Op: articleproto.DiffOp_DIFF_OP_EQUAL, Text: line})
+	}
+
+	mid, err := diffMiddle(x[prefix:len(x)-suffix], y[prefix:len(y)-suffix])
+	if err != nil {
+		return nil, err
+	}
+	res = append(res, mid...)
+
+	for _, line := range x[len(x)-suffix:] {
+		res = append(res, &articleproto.DiffLine{Op: articleproto.DiffOp_DIFF_OP_EQUAL, Text: line})
+	}
+
+	return res, nil
+}
+
+// diffMiddle diffs x and y through their longest common subsequence.
+func diffMiddle(x, y []string) ([]*articleproto.DiffLine, error) {
+	if len(x) > 0 && len(y) > maxDiffCells/len(x) {
+		return nil, errDiffTooLarge
+	}
+
+	// lcs[i*w+j] is the length of the longest common subsequence of x[i:]
+	// and y[j:].
+	w := len(y) + 1
+	lcs := make([]int32, (len(x)+1)*w)
+	for i := len(x) - 1; i >= 0; i-- {
+		for j := len(y) - 1; j >= 0; j-- {
+			if x[i] == y[j] {
+				lcs[i*w+j] = lcs[(i+1)*w+j+1] + 1
+			} else if lcs[(i+1)*w+j] >= lcs[i*w+j+1] {
+				lcs[i*w+j] = lcs[(i+1)*w+j]
+			} else {
+				lcs[i*w+j] = lcs[i*w+j+1]
+			}
+		}
+	}
+
+	res := make([]*articleproto.DiffLine, 0, len(x)+len(y))
+	i, j := 0, 0
+	for i < len(x) && j < len(y) {
+		switch {
+		case x[i] == y[j]:
+			res = append(res, &articleproto.DiffLine{Op: articleproto.DiffOp_DIFF_OP_EQUAL, Text: x[i]})
+			i++
+			j++
+		case lcs[(i+1)*w+j] >= lcs[i*w+j+1]:
+			res = append(res, &articleproto.DiffLine{Op: articleproto.DiffOp_DIFF_OP_DELETE, Text: x[i]})
+			i++
+		default:
+			res = append(res, &articleproto.DiffLine{Op: articleproto.DiffOp_DIFF_OP_INSERT, Text: y[j]})
+			j++
+		}
+	}
+	for ; i < len(x); i++ {
+		res = append(res, &articleproto.DiffLine{Op: articleproto.DiffOp_DIFF_OP_DELETE, Text: x[i]})
+	}
+	for ; j < len(y); j++ {
+		res = append(res, &articleproto.DiffLine{Op: articleproto.DiffOp_DIFF_OP_INSERT, Text: y[j]})
+	}
+
+	return res, nil
+}
+
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(strings.ReplaceAll(s, "\r\n", "\n"), "\n")
+}
@@ -0,0 +1,72 @@
+package article
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+
+	articleproto "github.com/uacademy/blogpost/article_service/proto-gen/blogpost"
+)
+
+func TestDiffLines(t *testing.T) {
+	got, err := diffLines("a\nb\nc\nd", "a\nc\nx\nd")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []struct {
+		op   articleproto.DiffOp
+		text string
+	}{
+		{articleproto.DiffOp_DIFF_OP_EQUAL, "a"},
+		{articleproto.DiffOp_DIFF_OP_DELETE, "b"},
+		{articleproto.DiffOp_DIFF_OP_EQUAL, "c"},
+		{articleproto.DiffOp_DIFF_OP_INSERT, "x"},
+		{articleproto.DiffOp_DIFF_OP_EQUAL, "d"},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %d lines, want %d", len(got), len(want))
+	}
+	for i, w := range want {
+		if got[i].Op != w.op || got[i].Text != w.text {
+			t.Errorf("line %d: got %v %q, want %v %q", i, got[i].Op, got[i].Text, w.op, w.text)
+		}
+	}
+}
+
+func lines(n int, prefix string) []string {
+	res := make([]string, n)
+	for i := range res {
+		res[i] = prefix + strconv.Itoa(i)
+	}
+	return res
+}
+
+func TestDiffLinesLargeEdit(t *testing.T) {
+	// A small edit in the middle of a large text only diffs the edit.
+	a := lines(50000, "line ")
+	b := append([]string{}, a...)
+	b[25000] = "changed"
+
+	got, err := diffLines(strings.Join(a, "\n"), strings.Join(b, "\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 50001 {
+		t.Fatalf("got %d lines, want 50001", len(got))
+	}
+	if got[25000].Op != articleproto.DiffOp_DIFF_OP_DELETE || got[25001].Op != articleproto.DiffOp_DIFF_OP_INSERT {
+		t.Errorf("got %v, %v around the edit", got[25000].Op, got[25001].Op)
+	}
+}
+
+func TestDiffLinesTooLarge(t *testing.T) {
+	a := strings.Join(lines(50000, "a "), "\n")
+	b := strings.Join(lines(50000, "b "), "\n")
+
+	_, err := diffLines(a, b)
+	if !errors.Is(err, errDiffTooLarge) {
+		t.Fatalf("got %v, want errDiffTooLarge", err)
+	}
+}
@@ -0,0 +1,56 @@
+// Package audit records who changed what through the gRPC services.
+package audit
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/uacademy/blogpost/article_service/proto-gen/blogpost"
+	"github.com/uacademy/blogpost/article_service/services/auth"
+	"github.com/uacademy/blogpost/article_service/services/requestid"
+	"github.com/uacademy/blogpost/article_service/storage"
+)
+
+// Entity types of audit events.
+const (
+	EntityArticle    = "article"
+	EntityAuthor     = "author"
+	EntityUserAuthor = "user_author"
+)
+
+// Record adds an audit event for the change the RPC in ctx made to an
+// entity. Pass the transaction the change runs in, so the event is only
+// kept when the change is. before or after is nil when the entity did not
+// exist, or was deleted, at that point.
+func Record(ctx context.Context, tx storage.StorageI, entityType, entityId string, before, after proto.Message) error {
+	event := &blogpost.AuditEvent{
+		EntityType: entityType,
+		EntityId:   entityId,
+		RequestId:  requestid.FromContext(ctx),
+	}
+	event.Rpc, _ = grpc.Method(ctx)
+	if id, ok := auth.FromContext(ctx); ok {
+		event.Actor = id.Subject
+	}
+
+	var err error
+	if event.Before, err = marshal(before); err != nil {
+		return err
+	}
+	if event.After, err = marshal(after); err != nil {
+		return err
+	}
+
+	return tx.AddAuditEvent(ctx, event)
+}
+
+func marshal(m proto.Message) (string, error) {
+	if m == nil || !m.ProtoReflect().IsValid() {
+		return "", nil
+	}
+	b, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(m)
+	return string(b), err
+}
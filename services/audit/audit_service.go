@@ -0,0 +1,47 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/uacademy/blogpost/article_service/config"
+	auditproto "github.com/uacademy/blogpost/article_service/proto-gen/blogpost"
+	"github.com/uacademy/blogpost/article_service/services/grpcerr"
+	"github.com/uacademy/blogpost/article_service/services/policy"
+	"github.com/uacademy/blogpost/article_service/storage"
+)
+
+type auditService struct {
+	cfg    config.Config
+	stg    storage.StorageI
+	policy policy.Policy
+	auditproto.UnimplementedAuditServiceServer
+}
+
+// NewAuditService ...
+func NewAuditService(cfg config.Config, stg storage.StorageI) *auditService {
+	return &auditService{
+		cfg:    cfg,
+		stg:    stg,
+		policy: policy.New(cfg),
+	}
+}
+
+func (s *auditService) ListAuditEvents(ctx context.Context, req *auditproto.ListAuditEventsRequest) (*auditproto.ListAuditEventsResponse, error) {
+	err := s.policy.RequireRole(ctx, "list audit events")
+	if err != nil {
+		return nil, grpcerr.FromStorage(err, "s.policy.RequireRole")
+	}
+
+	if req.From != nil && req.To != nil && !req.From.AsTime().Before(req.To.AsTime()) {
+		return nil, grpcerr.FromStorage(storage.InvalidArgument("to", "to must be after from"), "ListAuditEvents")
+	}
+
+	req.Limit = s.cfg.PageSize(req.Limit)
+
+	res, err := s.stg.ReadListAuditEvent(ctx, req)
+	if err != nil {
+		return nil, grpcerr.FromStorage(err, "s.stg.ReadListAuditEvent")
+	}
+
+	return res, nil
+}
@@ -0,0 +1,135 @@
+// Package auth verifies the JWT bearer tokens callers present and carries
+// their identity through request contexts.
+package auth
+
+import (
+	"context"
+	"crypto/rsa"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+
+	"github.com/uacademy/blogpost/article_service/config"
+)
+
+// Identity is the authenticated caller of a request.
+type Identity struct {
+	// Subject is the sub claim of the caller's token.
+	Subject string
+	Roles   []string
+}
+
+type identityKey struct{}
+
+// NewContext returns a copy of ctx carrying id.
+func NewContext(ctx context.Context, id *Identity) context.Context {
+	return context.WithValue(ctx, identityKey{}, id)
+}
+
+// FromContext returns the identity NewContext stored in ctx.
+func FromContext(ctx context.Context) (*Identity, bool) {
+	id, ok := ctx.Value(identityKey{}).(*Identity)
+	return id, ok
+}
+
+type claims struct {
+	jwt.RegisteredClaims
+	Roles []string `json:"roles"`
+}
+
+// Verifier checks tokens signed with HS256 by a shared secret or with
+// RS256 by one of the keys of a JWKS file.
+type Verifier struct {
+	secret   []byte
+	keys     map[string]*rsa.PublicKey
+	issuer   string
+	audience string
+	parser   *jwt.Parser
+}
+
+// NewVerifier sets up a Verifier from the JWT settings of cfg. The JWKS
+// file is read once, so key rotation needs a restart.
+func NewVerifier(cfg config.Config) (*Verifier, error) {
+	v := &Verifier{
+		issuer:   cfg.JWTIssuer,
+		audience: cfg.JWTAudience,
+	}
+
+	var methods []string
+	if cfg.JWTSecret != "" {
+		v.secret = []byte(cfg.JWTSecret)
+		methods = append(methods, jwt.SigningMethodHS256.Alg())
+	}
+	if cfg.JWKSFile != "" {
+		keys, err := loadJWKS(cfg.JWKSFile)
+		if err != nil {
+			return nil, fmt.Errorf("loadJWKS: %w", err)
+		}
+		v.keys = keys
+		methods = append(methods, jwt.SigningMethodRS256.Alg())
+	}
+	if len(methods) == 0 {
+		return nil, errors.New("neither JWT_HS256_SECRET nor JWT_JWKS_FILE is set")
+	}
+
+	v.parser = jwt.NewParser(jwt.WithValidMethods(methods))
+	return v, nil
+}
+
+// Verify checks the signature and claims of token and returns the caller
+// it identifies. Tokens without an expiry or a subject are rejected.
+func (v *Verifier) Verify(token string) (*Identity, error) {
+	var c claims
+	_, err := v.parser.ParseWithClaims(token, &c, v.key)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.ExpiresAt == nil {
+		return nil, errors.New("token has no expiry")
+	}
+	if c.Subject == "" {
+		return nil, errors.New("token has no subject")
+	}
+	if v.issuer != "" && !c.VerifyIssuer(v.issuer, true) {
+		return nil, errors.New("token has the wrong issuer")
+	}
+	if v.audience != "" && !c.VerifyAudience(v.audience, true) {
+		return nil, errors.New("token has the wrong audience")
+	}
+	if !c.VerifyExpiresAt(time.Now(), true) {
+		return nil, errors.New("token is expired")
+	}
+
+	return &Identity{
+		Subject: c.Subject,
+		Roles:   c.Roles,
+	}, nil
+}
+
+// key picks the key token must be signed with. The signing method is
+// checked here too, so an RSA public key is never used as an HMAC secret.
+func (v *Verifier) key(token *jwt.Token) (interface{}, error) {
+	switch token.Method.(type) {
+	case *jwt.SigningMethodHMAC:
+		if v.secret == nil {
+			return nil, errors.New("HS256 tokens are not accepted")
+		}
+		return v.secret, nil
+	case *jwt.SigningMethodRSA:
+		kid, _ := token.Header["kid"].(string)
+		if key, ok := v.keys[kid]; ok {
+			return key, nil
+		}
+		// A token without kid is fine as long as there is only one key.
+		if kid == "" && len(v.keys) == 1 {
+			for _, key := range v.keys {
+				return key, nil
+			}
+		}
+		return nil, fmt.Errorf("unknown key %q", kid)
+	}
+	return nil, fmt.Errorf("unexpected signing method %s", token.Method.Alg())
+}
@@ -0,0 +1,157 @@
+package auth
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+
+	"github.com/uacademy/blogpost/article_service/config"
+)
+
+const (
+	testSecret   = "secret"
+	testIssuer   = "https://issuer.example"
+	testAudience = "article_service"
+)
+
+// validClaims returns claims every verifier in the tests accepts.
+func validClaims() claims {
+	now := time.Now()
+	return claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   "user",
+			Issuer:    testIssuer,
+			Audience:  jwt.ClaimStrings{testAudience},
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(time.Hour)),
+		},
+		Roles: []string{"editor"},
+	}
+}
+
+func sign(t *testing.T, method jwt.SigningMethod, kid string, key interface{}, c claims) string {
+	t.Helper()
+	token := jwt.NewWithClaims(method, c)
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+	s, err := token.SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestVerifier(t *testing.T) {
+	key1, key2 := rsaKeys(t)
+
+	// The PEM of a public key is what an attacker would use as the
+	// HMAC secret of an alg confusion attack.
+	der, err := x509.MarshalPKIXPublicKey(&key1.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	publicPEM := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+
+	hsOnly, err := NewVerifier(config.Config{JWTSecret: testSecret, JWTIssuer: testIssuer, JWTAudience: testAudience})
+	if err != nil {
+		t.Fatal(err)
+	}
+	rsOnly, err := NewVerifier(config.Config{JWKSFile: writeJWKS(t, publicJWK("one", &key1.PublicKey), publicJWK("two", &key2.PublicKey)), JWTIssuer: testIssuer, JWTAudience: testAudience})
+	if err != nil {
+		t.Fatal(err)
+	}
+	rsSingle, err := NewVerifier(config.Config{JWKSFile: writeJWKS(t, publicJWK("one", &key1.PublicKey))})
+	if err != nil {
+		t.Fatal(err)
+	}
+	both, err := NewVerifier(config.Config{JWTSecret: testSecret, JWKSFile: writeJWKS(t, publicJWK("one", &key1.PublicKey)), JWTIssuer: testIssuer, JWTAudience: testAudience})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	claimsWith := func(change func(c *claims)) claims {
+		c := validClaims()
+		change(&c)
+		return c
+	}
+
+	tests := []struct {
+		name     string
+		verifier *Verifier
+		token    string
+		ok       bool
+	}{
+		{"HS256", hsOnly, sign(t, jwt.SigningMethodHS256, "", []byte(testSecret), validClaims()), true},
+		{"RS256", rsOnly, sign(t, jwt.SigningMethodRS256, "two", key2, validClaims()), true},
+		{"RS256 without kid and a single key", rsSingle, sign(t, jwt.SigningMethodRS256, "", key1, validClaims()), true},
+		{"HS256 and RS256 accept HS256", both, sign(t, jwt.SigningMethodHS256, "", []byte(testSecret), validClaims()), true},
+		{"HS256 and RS256 accept RS256", both, sign(t, jwt.SigningMethodRS256, "one", key1, validClaims()), true},
+
+		{"HS256 signed with the public key against RS256", rsOnly, sign(t, jwt.SigningMethodHS256, "one", publicPEM, validClaims()), false},
+		{"HS256 signed with the public key against both", both, sign(t, jwt.SigningMethodHS256, "one", publicPEM, validClaims()), false},
+		{"RS256 against HS256", hsOnly, sign(t, jwt.SigningMethodRS256, "one", key1, validClaims()), false},
+		{"RS512", rsOnly, sign(t, jwt.SigningMethodRS512, "one", key1, validClaims()), false},
+		{"alg none", both, sign(t, jwt.SigningMethodNone, "", jwt.UnsafeAllowNoneSignatureType, validClaims()), false},
+		{"wrong secret", hsOnly, sign(t, jwt.SigningMethodHS256, "", []byte("other"), validClaims()), false},
+		{"signed by another key", rsOnly, sign(t, jwt.SigningMethodRS256, "one", key2, validClaims()), false},
+
+		{"unknown kid", rsOnly, sign(t, jwt.SigningMethodRS256, "three", key1, validClaims()), false},
+		{"missing kid with several keys", rsOnly, sign(t, jwt.SigningMethodRS256, "", key1, validClaims()), false},
+
+		{"expired", hsOnly, sign(t, jwt.SigningMethodHS256, "", []byte(testSecret), claimsWith(func(c *claims) {
+			c.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-time.Minute))
+		})), false},
+		{"no expiry", hsOnly, sign(t, jwt.SigningMethodHS256, "", []byte(testSecret), claimsWith(func(c *claims) {
+			c.ExpiresAt = nil
+		})), false},
+		{"not yet valid", hsOnly, sign(t, jwt.SigningMethodHS256, "", []byte(testSecret), claimsWith(func(c *claims) {
+			c.NotBefore = jwt.NewNumericDate(time.Now().Add(time.Hour))
+		})), false},
+		{"issued in the future", hsOnly, sign(t, jwt.SigningMethodHS256, "", []byte(testSecret), claimsWith(func(c *claims) {
+			c.IssuedAt = jwt.NewNumericDate(time.Now().Add(time.Hour))
+		})), false},
+		{"no subject", hsOnly, sign(t, jwt.SigningMethodHS256, "", []byte(testSecret), claimsWith(func(c *claims) {
+			c.Subject = ""
+		})), false},
+
+		{"wrong issuer", hsOnly, sign(t, jwt.SigningMethodHS256, "", []byte(testSecret), claimsWith(func(c *claims) {
+			c.Issuer = "https://other.example"
+		})), false},
+		{"no issuer", hsOnly, sign(t, jwt.SigningMethodHS256, "", []byte(testSecret), claimsWith(func(c *claims) {
+			c.Issuer = ""
+		})), false},
+		{"wrong audience", hsOnly, sign(t, jwt.SigningMethodHS256, "", []byte(testSecret), claimsWith(func(c *claims) {
+			c.Audience = jwt.ClaimStrings{"other_service"}
+		})), false},
+		{"no audience", hsOnly, sign(t, jwt.SigningMethodHS256, "", []byte(testSecret), claimsWith(func(c *claims) {
+			c.Audience = nil
+		})), false},
+
+		{"malformed", hsOnly, "not.a.token", false},
+		{"empty", hsOnly, "", false},
+	}
+	for _, tt := range tests {
+		id, err := tt.verifier.Verify(tt.token)
+		if tt.ok {
+			if err != nil {
+				t.Errorf("%s: %v", tt.name, err)
+			} else if id.Subject != "user" || len(id.Roles) != 1 || id.Roles[0] != "editor" {
+				t.Errorf("%s: got identity %+v", tt.name, id)
+			}
+			continue
+		}
+		if err == nil {
+			t.Errorf("%s: accepted", tt.name)
+		}
+	}
+}
+
+func TestNewVerifierWithoutKeys(t *testing.T) {
+	if _, err := NewVerifier(config.Config{}); err == nil {
+		t.Error("a verifier without a secret or JWKS file was set up")
+	}
+}
@@ -0,0 +1,117 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+)
+
+var (
+	testKeysOnce sync.Once
+	testKeys     [2]*rsa.PrivateKey
+)
+
+// rsaKeys returns two RSA keys shared by the tests, as generating them is
+// slow.
+func rsaKeys(t *testing.T) (*rsa.PrivateKey, *rsa.PrivateKey) {
+	t.Helper()
+	testKeysOnce.Do(func() {
+		for i := range testKeys {
+			key, err := rsa.GenerateKey(rand.Reader, 2048)
+			if err != nil {
+				panic(err)
+			}
+			testKeys[i] = key
+		}
+	})
+	return testKeys[0], testKeys[1]
+}
+
+// publicJWK returns key as an RS256 signing JWK.
+func publicJWK(kid string, key *rsa.PublicKey) jwk {
+	return jwk{
+		Kty: "RSA",
+		Kid: kid,
+		Use: "sig",
+		Alg: "RS256",
+		N:   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+		E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+	}
+}
+
+// writeJWKS writes keys as a JWKS file and returns its path.
+func writeJWKS(t *testing.T, keys ...jwk) string {
+	t.Helper()
+	data, err := json.Marshal(map[string][]jwk{"keys": keys})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return writeFile(t, string(data))
+}
+
+func writeFile(t *testing.T, data string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadJWKS(t *testing.T) {
+	key1, key2 := rsaKeys(t)
+
+	encryption := publicJWK("enc", &key2.PublicKey)
+	encryption.Use = "enc"
+	ec := jwk{Kty: "EC", Kid: "ec"}
+
+	keys, err := loadJWKS(writeJWKS(t, publicJWK("one", &key1.PublicKey), publicJWK("two", &key2.PublicKey), encryption, ec))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keys) != 2 || keys["one"].N.Cmp(key1.N) != 0 || keys["two"].N.Cmp(key2.N) != 0 {
+		t.Errorf("got keys %v, want one and two", keys)
+	}
+}
+
+func TestLoadJWKSMalformed(t *testing.T) {
+	key1, key2 := rsaKeys(t)
+
+	badModulus := publicJWK("one", &key1.PublicKey)
+	badModulus.N = "not base64!"
+	badExponent := publicJWK("one", &key1.PublicKey)
+	badExponent.E = "not base64!"
+	smallExponent := publicJWK("one", &key1.PublicKey)
+	smallExponent.E = base64.RawURLEncoding.EncodeToString([]byte{1})
+	emptyModulus := publicJWK("one", &key1.PublicKey)
+	emptyModulus.N = ""
+	rs512 := publicJWK("one", &key1.PublicKey)
+	rs512.Alg = "RS512"
+
+	tests := []struct {
+		name string
+		path string
+	}{
+		{"missing file", filepath.Join(t.TempDir(), "missing.json")},
+		{"invalid JSON", writeFile(t, `{"keys": [`)},
+		{"keys not a list", writeFile(t, `{"keys": {}}`)},
+		{"no keys", writeJWKS(t)},
+		{"no RS256 keys", writeJWKS(t, rs512)},
+		{"bad modulus", writeJWKS(t, badModulus)},
+		{"bad exponent", writeJWKS(t, badExponent)},
+		{"small exponent", writeJWKS(t, smallExponent)},
+		{"empty modulus", writeJWKS(t, emptyModulus)},
+		{"duplicate kid", writeJWKS(t, publicJWK("one", &key1.PublicKey), publicJWK("one", &key2.PublicKey))},
+	}
+	for _, tt := range tests {
+		if keys, err := loadJWKS(tt.path); err == nil {
+			t.Errorf("%s: got %d keys, want an error", tt.name, len(keys))
+		}
+	}
+}
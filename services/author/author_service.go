@@ -2,25 +2,44 @@ package author
 
 import (
 	"context"
+	"fmt"
+	"strconv"
 
 	"github.com/google/uuid"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
 
 	authorproto "github.com/uacademy/blogpost/article_service/proto-gen/blogpost"
+	"github.com/uacademy/blogpost/article_service/config"
+	"github.com/uacademy/blogpost/article_service/services/audit"
+	"github.com/uacademy/blogpost/article_service/services/auth"
+	"github.com/uacademy/blogpost/article_service/services/grpcerr"
+	"github.com/uacademy/blogpost/article_service/services/policy"
+	"github.com/uacademy/blogpost/article_service/services/watch"
 	"github.com/uacademy/blogpost/article_service/storage"
 )
 
+// ArticlesAffectedHeader is the response header DeleteAuthor reports the
+// number of articles deleted or reassigned in.
+const ArticlesAffectedHeader = "x-articles-affected"
+
 // We define a AuthorService struct that implements the server interface.
 type authorService struct {
-	stg storage.StorageI
+	cfg      config.Config
+	stg      storage.StorageI
+	policy   policy.Policy
+	notifier storage.ChangeNotifier
 	authorproto.UnimplementedAuthorServiceServer
 }
 
 // NewAuthorService ...
-func NewAuthorService(stg storage.StorageI) *authorService {
+func NewAuthorService(cfg config.Config, stg storage.StorageI, notifier storage.ChangeNotifier) *authorService {
 	return &authorService{
-		stg: stg,
+		cfg:      cfg,
+		stg:      stg,
+		policy:   policy.New(cfg),
+		notifier: notifier,
 	}
 }
 
@@ -31,78 +50,352 @@ func (s *authorService) SayHello(ctx context.Context, in *authorproto.HelloReque
 }
 
 func (s *authorService) CreateAuthor(ctx context.Context, req *authorproto.CreateAuthorRequest) (*authorproto.Author, error) {
-	id := uuid.New()
-
-	err := s.stg.AddAuthor(id.String(), req)
+	err := s.policy.RequireRole(ctx, "create author")
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "s.stg.AddAuthor: %s", err.Error())
+		return nil, grpcerr.FromStorage(err, "s.policy.RequireRole")
 	}
 
-	author, err := s.stg.ReadAuthorById(id.String())
+	id := uuid.New()
+
+	var author *authorproto.GetAuthorByIdResponse
+	err = s.stg.WithTx(ctx, func(tx storage.StorageI) error {
+		err := tx.AddAuthor(ctx, id.String(), req)
+		if err != nil {
+			return fmt.Errorf("s.stg.AddAuthor: %w", err)
+		}
+
+		author, err = tx.ReadAuthorById(ctx, id.String())
+		if err != nil {
+			return fmt.Errorf("s.stg.ReadAuthorById: %w", err)
+		}
+
+		err = audit.Record(ctx, tx, audit.EntityAuthor, id.String(), nil, toAuthor(author))
+		if err != nil {
+			return fmt.Errorf("audit.Record: %w", err)
+		}
+		return nil
+	})
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "s.stg.ReadAuthorById: %s", err.Error())
+		return nil, grpcerr.FromStorage(err, "s.stg.WithTx")
 	}
 
-	return &authorproto.Author{
-		Id: author.Id,
-		Fullname: author.Fullname,
-		CreatedAt: author.CreatedAt,
-		UpdatedAt: author.UpdatedAt,
-	}, nil
+	return toAuthor(author), nil
 }
 
 func (s *authorService) UpdateAuthor(ctx context.Context, req *authorproto.UpdateAuthorRequest) (*authorproto.Author, error) {
-	err := s.stg.UpdateAuthor(req)
+	err := s.policy.RequireRole(ctx, "update author")
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "s.stg.UpdateAuthor: %s", err.Error())
+		return nil, grpcerr.FromStorage(err, "s.policy.RequireRole")
 	}
 
-	author, err := s.stg.ReadAuthorById(req.Id)
+	var author *authorproto.GetAuthorByIdResponse
+	err = s.stg.WithTx(ctx, func(tx storage.StorageI) error {
+		before, err := tx.ReadAuthorById(ctx, req.Id)
+		if err != nil {
+			return fmt.Errorf("s.stg.ReadAuthorById: %w", err)
+		}
+
+		err = tx.UpdateAuthor(ctx, req)
+		if err != nil {
+			return fmt.Errorf("s.stg.UpdateAuthor: %w", err)
+		}
+
+		author, err = tx.ReadAuthorById(ctx, req.Id)
+		if err != nil {
+			return fmt.Errorf("s.stg.ReadAuthorById: %w", err)
+		}
+
+		err = audit.Record(ctx, tx, audit.EntityAuthor, req.Id, toAuthor(before), toAuthor(author))
+		if err != nil {
+			return fmt.Errorf("audit.Record: %w", err)
+		}
+		return nil
+	})
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "s.stg.ReadAuthorById: %s", err.Error())
+		return nil, grpcerr.FromStorage(err, "s.stg.WithTx")
 	}
 	
-	return &authorproto.Author{
-		Id: author.Id,
-		Fullname: author.Fullname,
-		CreatedAt: author.CreatedAt,
-		UpdatedAt: author.UpdatedAt,
-	}, nil
+	return toAuthor(author), nil
 }
 
 func (s *authorService) DeleteAuthor(ctx context.Context, req *authorproto.DeleteAuthorRequest) (*authorproto.Author, error) {
-	author, err := s.stg.ReadAuthorById(req.Id)
+	err := s.policy.RequireRole(ctx, "delete author")
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "s.stg.ReadAuthorById: %s", err.Error())
+		return nil, grpcerr.FromStorage(err, "s.policy.RequireRole")
 	}
-	
-	err = s.stg.DeleteAuthor(req.Id)
+
+	var author *authorproto.GetAuthorByIdResponse
+	var affected []string
+	err = s.stg.WithTx(ctx, func(tx storage.StorageI) error {
+		var err error
+		author, err = tx.ReadAuthorById(ctx, req.Id)
+		if err != nil {
+			return fmt.Errorf("s.stg.ReadAuthorById: %w", err)
+		}
+
+		articles, err := tx.ReadListArticleByAuthor(ctx, req.Id)
+		if err != nil {
+			return fmt.Errorf("s.stg.ReadListArticleByAuthor: %w", err)
+		}
+
+		affected, err = tx.DeleteAuthor(ctx, req)
+		if err != nil {
+			return fmt.Errorf("s.stg.DeleteAuthor: %w", err)
+		}
+
+		err = audit.Record(ctx, tx, audit.EntityAuthor, req.Id, toAuthor(author), nil)
+		if err != nil {
+			return fmt.Errorf("audit.Record: %w", err)
+		}
+
+		return recordArticles(ctx, tx, req.Policy, articles, affected)
+	})
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "s.stg.DeleteAuthor: %s", err.Error())
+		return nil, grpcerr.FromStorage(err, "s.stg.WithTx")
 	}
 
+	_ = grpc.SetHeader(ctx, metadata.Pairs(ArticlesAffectedHeader, strconv.Itoa(len(affected))))
+
+	return toAuthor(author), nil
+}
+
+// recordArticles audits the articles DeleteAuthor deleted or reassigned.
+// before holds the author's articles as they were prior to the delete.
+func recordArticles(ctx context.Context, tx storage.StorageI, deletePolicy authorproto.DeleteAuthorPolicy, before []*authorproto.Article, ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	beforeById := make(map[string]*authorproto.Article, len(before))
+	for _, a := range before {
+		beforeById[a.Id] = a
+	}
+
+	// Cascaded articles are gone, reassigned ones are read back.
+	afterById := map[string]*authorproto.Article{}
+	if deletePolicy != authorproto.DeleteAuthorPolicy_DELETE_AUTHOR_POLICY_CASCADE {
+		after, err := tx.ReadArticlesById(ctx, ids)
+		if err != nil {
+			return fmt.Errorf("s.stg.ReadArticlesById: %w", err)
+		}
+		for _, a := range after {
+			afterById[a.Id] = a
+		}
+	}
+
+	for _, id := range ids {
+		var b, a proto.Message
+		if article, ok := beforeById[id]; ok {
+			b = article
+		}
+		if article, ok := afterById[id]; ok {
+			a = article
+		}
+
+		err := audit.Record(ctx, tx, audit.EntityArticle, id, b, a)
+		if err != nil {
+			return fmt.Errorf("audit.Record: %w", err)
+		}
+	}
+	return nil
+}
+
+func toAuthor(author *authorproto.GetAuthorByIdResponse) *authorproto.Author {
 	return &authorproto.Author{
 		Id: author.Id,
 		Fullname: author.Fullname,
 		CreatedAt: author.CreatedAt,
 		UpdatedAt: author.UpdatedAt,
-	}, nil
+		Version: author.Version,
+		Profile: author.Profile,
+		CreateTime: author.CreateTime,
+		UpdateTime: author.UpdateTime,
+	}
 }
 
 func (s *authorService) GetAuthorList(ctx context.Context, req *authorproto.GetAuthorListRequest) (*authorproto.GetAuthorListResponse, error) {
-	res, err := s.stg.ReadListAuthor(int(req.Offset), int(req.Limit), req.Search)
+	req.Limit = s.cfg.PageSize(req.Limit)
+
+	res, err := s.stg.ReadListAuthor(ctx, req)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "s.stg.ReadListAuthor: %s", err.Error())
+		return nil, grpcerr.FromStorage(err, "s.stg.ReadListAuthor")
 	}
 
 	return res, nil
 }
 
 func (s *authorService) GetAuthorById(ctx context.Context, req *authorproto.GetAuthorByIdRequest) (*authorproto.GetAuthorByIdResponse, error) {
-	author, err := s.stg.ReadAuthorById(req.Id)
+	author, err := s.stg.ReadAuthorById(ctx, req.Id)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "s.stg.ReadAuthorById: %s", err.Error())
+		return nil, grpcerr.FromStorage(err, "s.stg.ReadAuthorById")
 	}
 
 	return author, nil
 }
+
+func (s *authorService) RestoreAuthor(ctx context.Context, req *authorproto.RestoreAuthorRequest) (*authorproto.Author, error) {
+	err := s.policy.RequireRole(ctx, "restore author")
+	if err != nil {
+		return nil, grpcerr.FromStorage(err, "s.policy.RequireRole")
+	}
+
+	var author *authorproto.GetAuthorByIdResponse
+	err = s.stg.WithTx(ctx, func(tx storage.StorageI) error {
+		deleted, err := tx.ReadAuthorsById(ctx, []string{req.Id})
+		if err != nil {
+			return fmt.Errorf("s.stg.ReadAuthorsById: %w", err)
+		}
+
+		err = tx.RestoreAuthor(ctx, req.Id, req.ExpectedVersion)
+		if err != nil {
+			return fmt.Errorf("s.stg.RestoreAuthor: %w", err)
+		}
+
+		author, err = tx.ReadAuthorById(ctx, req.Id)
+		if err != nil {
+			return fmt.Errorf("s.stg.ReadAuthorById: %w", err)
+		}
+
+		var before proto.Message
+		if len(deleted) > 0 {
+			before = deleted[0]
+		}
+
+		err = audit.Record(ctx, tx, audit.EntityAuthor, req.Id, before, toAuthor(author))
+		if err != nil {
+			return fmt.Errorf("audit.Record: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, grpcerr.FromStorage(err, "s.stg.WithTx")
+	}
+
+	return toAuthor(author), nil
+}
+
+func (s *authorService) ListDeletedAuthors(ctx context.Context, req *authorproto.ListDeletedAuthorsRequest) (*authorproto.ListDeletedAuthorsResponse, error) {
+	err := s.policy.RequireRole(ctx, "list deleted authors")
+	if err != nil {
+		return nil, grpcerr.FromStorage(err, "s.policy.RequireRole")
+	}
+
+	req.Limit = s.cfg.PageSize(req.Limit)
+
+	res, err := s.stg.ReadListDeletedAuthor(ctx, req)
+	if err != nil {
+		return nil, grpcerr.FromStorage(err, "s.stg.ReadListDeletedAuthor")
+	}
+
+	return res, nil
+}
+
+func (s *authorService) LinkUserToAuthor(ctx context.Context, req *authorproto.LinkUserToAuthorRequest) (*authorproto.UserAuthorLink, error) {
+	err := s.policy.RequireRole(ctx, "link user to author")
+	if err != nil {
+		return nil, grpcerr.FromStorage(err, "s.policy.RequireRole")
+	}
+
+	var link *authorproto.UserAuthorLink
+	err = s.stg.WithTx(ctx, func(tx storage.StorageI) error {
+		err := tx.AddUserAuthorLink(ctx, req.UserId, req.AuthorId)
+		if err != nil {
+			return fmt.Errorf("s.stg.AddUserAuthorLink: %w", err)
+		}
+
+		link, err = tx.ReadUserAuthorLink(ctx, req.UserId, req.AuthorId)
+		if err != nil {
+			return fmt.Errorf("s.stg.ReadUserAuthorLink: %w", err)
+		}
+
+		err = audit.Record(ctx, tx, audit.EntityUserAuthor, req.UserId+"/"+req.AuthorId, nil, link)
+		if err != nil {
+			return fmt.Errorf("audit.Record: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, grpcerr.FromStorage(err, "s.stg.WithTx")
+	}
+
+	return link, nil
+}
+
+func (s *authorService) UnlinkUserFromAuthor(ctx context.Context, req *authorproto.UnlinkUserFromAuthorRequest) (*authorproto.UserAuthorLink, error) {
+	err := s.policy.RequireRole(ctx, "unlink user from author")
+	if err != nil {
+		return nil, grpcerr.FromStorage(err, "s.policy.RequireRole")
+	}
+
+	var link *authorproto.UserAuthorLink
+	err = s.stg.WithTx(ctx, func(tx storage.StorageI) error {
+		var err error
+		link, err = tx.ReadUserAuthorLink(ctx, req.UserId, req.AuthorId)
+		if err != nil {
+			return fmt.Errorf("s.stg.ReadUserAuthorLink: %w", err)
+		}
+
+		err = tx.DeleteUserAuthorLink(ctx, req.UserId, req.AuthorId)
+		if err != nil {
+			return fmt.Errorf("s.stg.DeleteUserAuthorLink: %w", err)
+		}
+
+		err = audit.Record(ctx, tx, audit.EntityUserAuthor, req.UserId+"/"+req.AuthorId, link, nil)
+		if err != nil {
+			return fmt.Errorf("audit.Record: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, grpcerr.FromStorage(err, "s.stg.WithTx")
+	}
+
+	return link, nil
+}
+
+func (s *authorService) ListUserAuthors(ctx context.Context, req *authorproto.ListUserAuthorsRequest) (*authorproto.ListUserAuthorsResponse, error) {
+	userId := req.UserId
+	if userId == "" {
+		if id, ok := auth.FromContext(ctx); ok {
+			userId = id.Subject
+		}
+	}
+	if userId == "" {
+		return nil, grpcerr.FromStorage(storage.InvalidArgument("user_id", "user_id is required"), "ListUserAuthors")
+	}
+
+	if !s.policy.IsSelfOrAdmin(ctx, userId) {
+		err := storage.PermissionDenied("", "", policy.ReasonMissingRole, "listing the authors of other users requires the role "+policy.RoleAdmin)
+		return nil, grpcerr.FromStorage(err, "s.policy.IsSelfOrAdmin")
+	}
+
+	res, err := s.stg.ReadListUserAuthorLink(ctx, userId)
+	if err != nil {
+		return nil, grpcerr.FromStorage(err, "s.stg.ReadListUserAuthorLink")
+	}
+
+	return res, nil
+}
+
+func (s *authorService) WatchAuthors(req *authorproto.WatchAuthorsRequest, stream authorproto.AuthorService_WatchAuthorsServer) error {
+	ctx := stream.Context()
+	err := s.policy.RequireRole(ctx, "watch authors", policy.RoleEditor)
+	if err != nil {
+		return grpcerr.FromStorage(err, "s.policy.RequireRole")
+	}
+
+	return watch.Run(ctx, s.stg, s.notifier, audit.EntityAuthor, req.AfterSequence, func(ctx context.Context, afterSequence int64) (int64, error) {
+		events, err := s.stg.ReadListAuthorEvent(ctx, afterSequence, watch.BatchSize)
+		if err != nil {
+			return afterSequence, grpcerr.FromStorage(err, "s.stg.ReadListAuthorEvent")
+		}
+
+		for _, e := range events {
+			if err := stream.Send(e); err != nil {
+				return afterSequence, err
+			}
+			afterSequence = e.Sequence
+		}
+		return afterSequence, nil
+	})
+}
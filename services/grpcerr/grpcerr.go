@@ -0,0 +1,94 @@
+// Package grpcerr maps storage errors to gRPC statuses.
+package grpcerr
+
+import (
+	"context"
+	"errors"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/uacademy/blogpost/article_service/storage"
+)
+
+// FromStorage converts an error returned by op into a gRPC status error.
+// Typed storage errors get a matching code and error details, calls cut
+// short by their context DeadlineExceeded or Canceled, and anything else
+// is reported as Internal.
+func FromStorage(err error, op string) error {
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+		return status.FromContextError(err).Err()
+	}
+
+	var se *storage.Error
+	if !errors.As(err, &se) {
+		return status.Errorf(codes.Internal, "%s: %s", op, err.Error())
+	}
+
+	var code codes.Code
+	var details []proto.Message
+
+	resourceInfo := &errdetails.ResourceInfo{
+		ResourceType: se.Resource,
+		ResourceName: se.Name,
+		Description:  se.Message,
+	}
+	fieldViolation := &errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{{
+			Field:       se.Field,
+			Description: se.Message,
+		}},
+	}
+
+	switch se.Kind {
+	case storage.ErrNotFound:
+		code = codes.NotFound
+		details = append(details, resourceInfo)
+	case storage.ErrAlreadyExists:
+		code = codes.AlreadyExists
+		details = append(details, resourceInfo, fieldViolation)
+	case storage.ErrForeignKey:
+		code = codes.FailedPrecondition
+		details = append(details, resourceInfo, &errdetails.PreconditionFailure{
+			Violations: []*errdetails.PreconditionFailure_Violation{{
+				Type:        "REFERENCE",
+				Subject:     se.Field,
+				Description: se.Message,
+			}},
+		})
+	case storage.ErrInvalidState:
+		code = codes.FailedPrecondition
+		details = append(details, resourceInfo, &errdetails.PreconditionFailure{
+			Violations: []*errdetails.PreconditionFailure_Violation{{
+				Type:        "STATE",
+				Subject:     se.Resource,
+				Description: se.Message,
+			}},
+		})
+	case storage.ErrConflict:
+		code = codes.Aborted
+		details = append(details, resourceInfo)
+	case storage.ErrInvalidArgument:
+		code = codes.InvalidArgument
+		details = append(details, fieldViolation)
+	case storage.ErrPermission:
+		code = codes.PermissionDenied
+		details = append(details, &errdetails.ErrorInfo{
+			Reason: se.Reason,
+			Domain: "blogpost",
+		})
+		if se.Name != "" {
+			details = append(details, resourceInfo)
+		}
+	default:
+		code = codes.Internal
+	}
+
+	st, detailsErr := status.New(code, se.Message).WithDetails(details...)
+	if detailsErr != nil {
+		return status.Error(code, se.Message)
+	}
+	return st.Err()
+}
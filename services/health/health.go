@@ -0,0 +1,127 @@
+package health
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/uacademy/blogpost/article_service/config"
+)
+
+// Readiness is the service name probes should check to know whether the
+// server may receive traffic. It only reports SERVING once migrations have
+// finished and the database answers pings.
+//
+// The empty service name is the liveness check: it stays SERVING for as
+// long as the process is up and flips to NOT_SERVING on shutdown.
+const Readiness = "readiness"
+
+// Pinger reports whether the database is reachable.
+type Pinger interface {
+	Ping(ctx context.Context) error
+}
+
+// Checker keeps the grpc.health.v1.Health statuses in sync with the
+// database and the server lifecycle.
+type Checker struct {
+	srv      *grpchealth.Server
+	db       Pinger
+	services []string
+	interval time.Duration
+	timeout  time.Duration
+
+	mu    sync.Mutex
+	ready bool
+	dbUp  bool
+}
+
+// NewChecker creates a checker reporting on the given gRPC services.
+// Everything but liveness starts as NOT_SERVING until SetReady is called.
+func NewChecker(cfg config.Config, db Pinger, services ...string) *Checker {
+	c := &Checker{
+		srv:      grpchealth.NewServer(),
+		db:       db,
+		services: services,
+		interval: cfg.HealthCheckInterval,
+		timeout:  cfg.HealthCheckTimeout,
+	}
+	c.update()
+	return c
+}
+
+// Register adds the health service to s.
+func (c *Checker) Register(s *grpc.Server) {
+	healthpb.RegisterHealthServer(s, c.srv)
+}
+
+// SetReady marks the startup work (migrations) as done.
+func (c *Checker) SetReady() {
+	c.mu.Lock()
+	c.ready = true
+	c.mu.Unlock()
+
+	c.check(context.Background())
+}
+
+// Run pings the database every interval until ctx is done.
+func (c *Checker) Run(ctx context.Context) {
+	c.check(ctx)
+
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			c.check(ctx)
+		}
+	}
+}
+
+// Shutdown reports every service, liveness included, as NOT_SERVING and
+// ignores any later update.
+func (c *Checker) Shutdown() {
+	c.srv.Shutdown()
+}
+
+func (c *Checker) check(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	err := c.db.Ping(ctx)
+
+	c.mu.Lock()
+	if err != nil && c.dbUp {
+		log.Printf("health: database ping failed: %v", err)
+	} else if err == nil && !c.dbUp && c.ready {
+		log.Println("health: database is reachable")
+	}
+	c.dbUp = err == nil
+	c.mu.Unlock()
+
+	c.update()
+}
+
+func (c *Checker) update() {
+	c.mu.Lock()
+	serving := c.ready && c.dbUp
+	c.mu.Unlock()
+
+	status := healthpb.HealthCheckResponse_NOT_SERVING
+	if serving {
+		status = healthpb.HealthCheckResponse_SERVING
+	}
+
+	c.srv.SetServingStatus("", healthpb.HealthCheckResponse_SERVING)
+	c.srv.SetServingStatus(Readiness, status)
+	for _, name := range c.services {
+		c.srv.SetServingStatus(name, status)
+	}
+}
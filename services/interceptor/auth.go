@@ -0,0 +1,103 @@
+package interceptor
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/uacademy/blogpost/article_service/services/auth"
+)
+
+// UnaryAuth verifies the bearer token in the authorization metadata and
+// stores the caller's identity in the context. Methods listed in public
+// may be called without a token; they are given as method names, full
+// method names or service names.
+func UnaryAuth(v *auth.Verifier, public []string) grpc.UnaryServerInterceptor {
+	isPublic := publicMethods(public)
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := authenticate(ctx, v, isPublic(info.FullMethod))
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamAuth is the streaming counterpart of UnaryAuth.
+func StreamAuth(v *auth.Verifier, public []string) grpc.StreamServerInterceptor {
+	isPublic := publicMethods(public)
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := authenticate(ss.Context(), v, isPublic(info.FullMethod))
+		if err != nil {
+			return err
+		}
+		return handler(srv, &contextStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// contextStream replaces the context of a server stream.
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextStream) Context() context.Context {
+	return s.ctx
+}
+
+// authenticate attaches the identity of the caller to ctx. A token that
+// is present has to be valid even on public methods.
+func authenticate(ctx context.Context, v *auth.Verifier, public bool) (context.Context, error) {
+	token, err := bearerToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if token == "" {
+		if public {
+			return ctx, nil
+		}
+		return nil, status.Error(codes.Unauthenticated, "missing bearer token")
+	}
+
+	id, err := v.Verify(token)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid token: %s", err)
+	}
+	return auth.NewContext(ctx, id), nil
+}
+
+// bearerToken returns the token of the authorization metadata, or "" when
+// there is none.
+func bearerToken(ctx context.Context) (string, error) {
+	values := metadata.ValueFromIncomingContext(ctx, "authorization")
+	if len(values) == 0 {
+		return "", nil
+	}
+	if len(values) > 1 {
+		return "", status.Error(codes.Unauthenticated, "more than one authorization header")
+	}
+
+	scheme, token, ok := strings.Cut(values[0], " ")
+	if !ok || !strings.EqualFold(scheme, "bearer") || strings.TrimSpace(token) == "" {
+		return "", status.Error(codes.Unauthenticated, "authorization must be a bearer token")
+	}
+	return strings.TrimSpace(token), nil
+}
+
+// publicMethods returns a matcher for full method names such as
+// /ArticleService/GetArticleById against the given entries.
+func publicMethods(entries []string) func(fullMethod string) bool {
+	set := make(map[string]bool, len(entries))
+	for _, e := range entries {
+		set[e] = true
+	}
+
+	return func(fullMethod string) bool {
+		service, method, _ := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/")
+		return set[fullMethod] || set[method] || set[service]
+	}
+}
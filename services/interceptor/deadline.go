@@ -0,0 +1,25 @@
+package interceptor
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	"github.com/uacademy/blogpost/article_service/config"
+)
+
+// UnaryDeadline bounds every call by the timeout configured for its
+// method, so storage queries are cancelled even when the client sent no
+// deadline. A shorter client deadline still wins.
+func UnaryDeadline(cfg config.Config) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		timeout := cfg.RPCTimeout(info.FullMethod)
+		if timeout <= 0 {
+			return handler(ctx, req)
+		}
+
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		return handler(ctx, req)
+	}
+}
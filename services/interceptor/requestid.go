@@ -0,0 +1,38 @@
+package interceptor
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/uacademy/blogpost/article_service/services/requestid"
+)
+
+// maxRequestIdLen bounds client supplied request ids.
+const maxRequestIdLen = 128
+
+// UnaryRequestId stores the x-request-id sent by the client in the
+// context, or a new one when there is none, and echoes it back in the
+// response header.
+func UnaryRequestId(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	id := requestId(ctx)
+	_ = grpc.SetHeader(ctx, metadata.Pairs(requestid.Key, id))
+	return handler(requestid.NewContext(ctx, id), req)
+}
+
+// StreamRequestId is the streaming counterpart of UnaryRequestId.
+func StreamRequestId(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	id := requestId(ss.Context())
+	_ = ss.SetHeader(metadata.Pairs(requestid.Key, id))
+	return handler(srv, &contextStream{ServerStream: ss, ctx: requestid.NewContext(ss.Context(), id)})
+}
+
+func requestId(ctx context.Context) string {
+	values := metadata.ValueFromIncomingContext(ctx, requestid.Key)
+	if len(values) > 0 && values[0] != "" && len(values[0]) <= maxRequestIdLen {
+		return values[0]
+	}
+	return uuid.NewString()
+}
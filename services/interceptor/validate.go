@@ -0,0 +1,130 @@
+// Package interceptor holds the gRPC server interceptors shared by all services.
+package interceptor
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// validationError is implemented by the errors protoc-gen-validate
+// generates for a single field.
+type validationError interface {
+	Field() string
+	Reason() string
+	Cause() error
+}
+
+// multiError is implemented by the errors ValidateAll returns.
+type multiError interface {
+	AllErrors() []error
+}
+
+// UnaryValidator rejects requests breaking the (validate.rules) declared
+// on their proto messages with InvalidArgument.
+func UnaryValidator(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := validate(req); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// StreamValidator validates every message a client sends on a stream.
+func StreamValidator(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return handler(srv, &validatingStream{ServerStream: ss})
+}
+
+type validatingStream struct {
+	grpc.ServerStream
+}
+
+func (s *validatingStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return validate(m)
+}
+
+func validate(req interface{}) error {
+	v, ok := req.(interface{ ValidateAll() error })
+	if !ok {
+		return nil
+	}
+
+	err := v.ValidateAll()
+	if err == nil {
+		return nil
+	}
+
+	var md protoreflect.MessageDescriptor
+	if m, ok := req.(proto.Message); ok {
+		md = m.ProtoReflect().Descriptor()
+	}
+
+	st := status.New(codes.InvalidArgument, err.Error())
+	withDetails, detailsErr := st.WithDetails(&errdetails.BadRequest{
+		FieldViolations: fieldViolations(md, "", err),
+	})
+	if detailsErr != nil {
+		return st.Err()
+	}
+	return withDetails.Err()
+}
+
+// fieldViolations flattens validation errors, descending into embedded
+// messages, and reports fields by their proto path, e.g. content.title.
+func fieldViolations(md protoreflect.MessageDescriptor, prefix string, err error) []*errdetails.BadRequest_FieldViolation {
+	if m, ok := err.(multiError); ok {
+		var res []*errdetails.BadRequest_FieldViolation
+		for _, e := range m.AllErrors() {
+			res = append(res, fieldViolations(md, prefix, e)...)
+		}
+		return res
+	}
+
+	v, ok := err.(validationError)
+	if !ok {
+		return []*errdetails.BadRequest_FieldViolation{{Field: prefix, Description: err.Error()}}
+	}
+
+	name, fd := protoFieldName(md, v.Field())
+	path := name
+	if prefix != "" {
+		path = prefix + "." + name
+	}
+
+	if fd != nil && fd.Message() != nil && v.Cause() != nil {
+		return fieldViolations(fd.Message(), path, v.Cause())
+	}
+
+	return []*errdetails.BadRequest_FieldViolation{{Field: path, Description: v.Reason()}}
+}
+
+// protoFieldName maps the Go field name used by protoc-gen-validate, such
+// as AuthorId or Tags[2], back to the proto field name.
+func protoFieldName(md protoreflect.MessageDescriptor, goName string) (string, protoreflect.FieldDescriptor) {
+	if md == nil {
+		return goName, nil
+	}
+
+	name, index := goName, ""
+	if i := strings.IndexByte(goName, '['); i >= 0 {
+		name, index = goName[:i], goName[i:]
+	}
+
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if strings.EqualFold(strings.ReplaceAll(string(fd.Name()), "_", ""), name) {
+			return string(fd.Name()) + index, fd
+		}
+	}
+
+	return goName, nil
+}
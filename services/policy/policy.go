@@ -0,0 +1,155 @@
+// Package policy decides which writes an authenticated caller may make.
+//
+// Admins may do anything. Editors may change and read any article, while
+// everyone else only reads published ones. Users with the author role may
+// only change, and read unpublished, articles of the authors they are
+// linked to. Authors themselves are managed by admins.
+package policy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/uacademy/blogpost/article_service/config"
+	"github.com/uacademy/blogpost/article_service/services/auth"
+	"github.com/uacademy/blogpost/article_service/storage"
+)
+
+// Roles carried in the roles claim of a token.
+const (
+	RoleAdmin  = "admin"
+	RoleEditor = "editor"
+	RoleAuthor = "author"
+)
+
+// Reasons reported in the ErrorInfo of PermissionDenied errors.
+const (
+	ReasonUnauthenticated = "UNAUTHENTICATED"
+	ReasonMissingRole     = "MISSING_ROLE"
+	ReasonNotArticleOwner = "NOT_ARTICLE_OWNER"
+)
+
+// Policy checks callers against the rules above. With authentication
+// switched off every call is allowed.
+type Policy struct {
+	enabled bool
+}
+
+// New ...
+func New(cfg config.Config) Policy {
+	return Policy{enabled: cfg.AuthEnabled}
+}
+
+// RequireRole checks that the caller has one of roles, or is an admin.
+// action describes the call in the error, e.g. "delete author".
+func (p Policy) RequireRole(ctx context.Context, action string, roles ...string) error {
+	if !p.enabled {
+		return nil
+	}
+
+	id, err := identity(ctx, action)
+	if err != nil {
+		return err
+	}
+	if hasRole(id, RoleAdmin) {
+		return nil
+	}
+	for _, role := range roles {
+		if hasRole(id, role) {
+			return nil
+		}
+	}
+
+	return storage.PermissionDenied("", "", ReasonMissingRole,
+		fmt.Sprintf("%s requires one of the roles %s", action, strings.Join(append(append([]string{}, roles...), RoleAdmin), ", ")))
+}
+
+// WriteArticle checks that the caller may create or change an article of
+// authorId. stg is consulted for the caller's author links, so pass the
+// transaction the write runs in.
+func (p Policy) WriteArticle(ctx context.Context, stg storage.StorageI, action, articleId, authorId string) error {
+	if !p.enabled {
+		return nil
+	}
+
+	id, err := identity(ctx, action)
+	if err != nil {
+		return err
+	}
+	if hasRole(id, RoleAdmin) || hasRole(id, RoleEditor) {
+		return nil
+	}
+	if !hasRole(id, RoleAuthor) {
+		return storage.PermissionDenied("article", articleId, ReasonMissingRole,
+			fmt.Sprintf("%s requires one of the roles %s, %s, %s", action, RoleAuthor, RoleEditor, RoleAdmin))
+	}
+
+	if authorId != "" {
+		_, err = stg.ReadUserAuthorLink(ctx, id.Subject, authorId)
+		if err == nil {
+			return nil
+		}
+		if !errors.Is(err, storage.ErrNotFound) {
+			return err
+		}
+	}
+
+	return storage.PermissionDenied("article", articleId, ReasonNotArticleOwner,
+		fmt.Sprintf("%s is only allowed on articles of authors linked to user %s", action, id.Subject))
+}
+
+// CanReadUnpublished reports whether the caller may read draft and
+// archived articles of authorId, which takes the editor role or the author
+// role and a link to authorId. An empty authorId stands for the articles
+// of every author. stg is consulted for the caller's author links.
+func (p Policy) CanReadUnpublished(ctx context.Context, stg storage.StorageI, authorId string) (bool, error) {
+	if !p.enabled {
+		return true, nil
+	}
+
+	id, ok := auth.FromContext(ctx)
+	if !ok {
+		return false, nil
+	}
+	if hasRole(id, RoleEditor) || hasRole(id, RoleAdmin) {
+		return true, nil
+	}
+	if authorId == "" || !hasRole(id, RoleAuthor) {
+		return false, nil
+	}
+
+	_, err := stg.ReadUserAuthorLink(ctx, id.Subject, authorId)
+	if errors.Is(err, storage.ErrNotFound) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// IsSelfOrAdmin reports whether the caller is userId or an admin.
+func (p Policy) IsSelfOrAdmin(ctx context.Context, userId string) bool {
+	if !p.enabled {
+		return true
+	}
+
+	id, ok := auth.FromContext(ctx)
+	return ok && (id.Subject == userId || hasRole(id, RoleAdmin))
+}
+
+func identity(ctx context.Context, action string) (*auth.Identity, error) {
+	id, ok := auth.FromContext(ctx)
+	if !ok {
+		return nil, storage.PermissionDenied("", "", ReasonUnauthenticated, action+" requires an authenticated caller")
+	}
+	return id, nil
+}
+
+func hasRole(id *auth.Identity, role string) bool {
+	for _, r := range id.Roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
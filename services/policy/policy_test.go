@@ -0,0 +1,152 @@
+package policy
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/uacademy/blogpost/article_service/config"
+	"github.com/uacademy/blogpost/article_service/proto-gen/blogpost"
+	"github.com/uacademy/blogpost/article_service/services/auth"
+	"github.com/uacademy/blogpost/article_service/storage"
+)
+
+const (
+	userId        = "user"
+	ownAuthorId   = "own-author"
+	otherAuthorId = "other-author"
+)
+
+// linkStorage knows a single link, of userId to ownAuthorId.
+type linkStorage struct {
+	storage.StorageI
+	err error
+}
+
+func (s linkStorage) ReadUserAuthorLink(ctx context.Context, user, author string) (*blogpost.UserAuthorLink, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	if user != userId || author != ownAuthorId {
+		return nil, storage.NotFound("user_author", user+"/"+author)
+	}
+	return &blogpost.UserAuthorLink{UserId: user, AuthorId: author}, nil
+}
+
+// ops run an operation on the articles of authorId and report whether it
+// was allowed.
+var ops = map[string]func(ctx context.Context, p Policy, authorId string) (bool, error){
+	"require admin": func(ctx context.Context, p Policy, authorId string) (bool, error) {
+		return allowed(p.RequireRole(ctx, "delete author"))
+	},
+	"require editor": func(ctx context.Context, p Policy, authorId string) (bool, error) {
+		return allowed(p.RequireRole(ctx, "list deleted articles", RoleEditor))
+	},
+	"write article": func(ctx context.Context, p Policy, authorId string) (bool, error) {
+		return allowed(p.WriteArticle(ctx, linkStorage{}, "update article", "article", authorId))
+	},
+	"read unpublished": func(ctx context.Context, p Policy, authorId string) (bool, error) {
+		return p.CanReadUnpublished(ctx, linkStorage{}, authorId)
+	},
+}
+
+func allowed(err error) (bool, error) {
+	if errors.Is(err, storage.ErrPermission) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func TestPolicy(t *testing.T) {
+	tests := []struct {
+		role      string // "" for a caller without roles
+		anonymous bool
+		op        string
+		wantOwn   bool
+		wantOther bool
+	}{
+		{anonymous: true, op: "require admin"},
+		{anonymous: true, op: "require editor"},
+		{anonymous: true, op: "write article"},
+		{anonymous: true, op: "read unpublished"},
+
+		{op: "require admin"},
+		{op: "require editor"},
+		{op: "write article"},
+		{op: "read unpublished"},
+
+		{role: RoleAuthor, op: "require admin"},
+		{role: RoleAuthor, op: "require editor"},
+		{role: RoleAuthor, op: "write article", wantOwn: true},
+		{role: RoleAuthor, op: "read unpublished", wantOwn: true},
+
+		{role: RoleEditor, op: "require admin"},
+		{role: RoleEditor, op: "require editor", wantOwn: true, wantOther: true},
+		{role: RoleEditor, op: "write article", wantOwn: true, wantOther: true},
+		{role: RoleEditor, op: "read unpublished", wantOwn: true, wantOther: true},
+
+		{role: RoleAdmin, op: "require admin", wantOwn: true, wantOther: true},
+		{role: RoleAdmin, op: "require editor", wantOwn: true, wantOther: true},
+		{role: RoleAdmin, op: "write article", wantOwn: true, wantOther: true},
+		{role: RoleAdmin, op: "read unpublished", wantOwn: true, wantOther: true},
+	}
+
+	p := New(config.Config{AuthEnabled: true})
+	for _, tt := range tests {
+		ctx := context.Background()
+		name := "anonymous"
+		if !tt.anonymous {
+			id := &auth.Identity{Subject: userId}
+			name = "no role"
+			if tt.role != "" {
+				id.Roles = []string{tt.role}
+				name = tt.role
+			}
+			ctx = auth.NewContext(ctx, id)
+		}
+
+		for _, c := range []struct {
+			authorId string
+			want     bool
+		}{
+			{ownAuthorId, tt.wantOwn},
+			{otherAuthorId, tt.wantOther},
+		} {
+			got, err := ops[tt.op](ctx, p, c.authorId)
+			if err != nil {
+				t.Errorf("%s, %s on %s: %v", name, tt.op, c.authorId, err)
+				continue
+			}
+			if got != c.want {
+				t.Errorf("%s, %s on %s: allowed %v, want %v", name, tt.op, c.authorId, got, c.want)
+			}
+		}
+	}
+}
+
+func TestPolicyDisabled(t *testing.T) {
+	p := New(config.Config{AuthEnabled: false})
+	for op, run := range ops {
+		got, err := run(context.Background(), p, otherAuthorId)
+		if err != nil || !got {
+			t.Errorf("%s: allowed %v, %v with authentication switched off", op, got, err)
+		}
+	}
+}
+
+func TestCanReadUnpublishedAllAuthors(t *testing.T) {
+	ctx := auth.NewContext(context.Background(), &auth.Identity{Subject: userId, Roles: []string{RoleAuthor}})
+	got, err := New(config.Config{AuthEnabled: true}).CanReadUnpublished(ctx, linkStorage{}, "")
+	if err != nil || got {
+		t.Errorf("an author may read the unpublished articles of every author: %v, %v", got, err)
+	}
+}
+
+func TestCanReadUnpublishedLookupError(t *testing.T) {
+	lookupErr := errors.New("connection reset")
+	ctx := auth.NewContext(context.Background(), &auth.Identity{Subject: userId, Roles: []string{RoleAuthor}})
+	_, err := New(config.Config{AuthEnabled: true}).CanReadUnpublished(ctx, linkStorage{err: lookupErr}, ownAuthorId)
+	if !errors.Is(err, lookupErr) {
+		t.Errorf("got %v, want the lookup error", err)
+	}
+}
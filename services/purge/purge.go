@@ -0,0 +1,74 @@
+package purge
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/uacademy/blogpost/article_service/config"
+	"github.com/uacademy/blogpost/article_service/storage"
+)
+
+// batchSize bounds how many rows of each kind one transaction removes, so
+// a large backlog does not hold locks for long.
+const batchSize = 500
+
+// Purger hard-deletes articles and authors that have been soft-deleted
+// for longer than the retention period.
+type Purger struct {
+	stg       storage.StorageI
+	retention time.Duration
+	interval  time.Duration
+}
+
+// NewPurger ...
+func NewPurger(cfg config.Config, stg storage.StorageI) *Purger {
+	return &Purger{
+		stg:       stg,
+		retention: cfg.PurgeRetention,
+		interval:  cfg.PurgeInterval,
+	}
+}
+
+// Run purges every interval until ctx is done. It returns at once when
+// the retention period is not positive, which keeps deleted rows forever.
+func (p *Purger) Run(ctx context.Context) {
+	if p.retention <= 0 || p.interval <= 0 {
+		log.Println("purge: disabled")
+		return
+	}
+
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		p.purge(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (p *Purger) purge(ctx context.Context) {
+	var totalArticles, totalAuthors int64
+	for ctx.Err() == nil {
+		articles, authors, err := p.stg.PurgeDeleted(ctx, p.retention, batchSize)
+		if err != nil {
+			log.Printf("purge: %v", err)
+			break
+		}
+
+		totalArticles += articles
+		totalAuthors += authors
+		if articles < batchSize && authors < batchSize {
+			break
+		}
+	}
+
+	if totalArticles > 0 || totalAuthors > 0 {
+		log.Printf("purge: removed %d articles and %d authors deleted more than %s ago", totalArticles, totalAuthors, p.retention)
+	}
+}
@@ -0,0 +1,22 @@
+// Package requestid carries the id of the request being served, so it can
+// be logged and recorded alongside what the request did.
+package requestid
+
+import "context"
+
+// Key is the metadata key clients may send a request id in. The server
+// echoes the id it used in the response header of the same name.
+const Key = "x-request-id"
+
+type requestIdKey struct{}
+
+// NewContext returns a copy of ctx carrying id.
+func NewContext(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIdKey{}, id)
+}
+
+// FromContext returns the request id stored in ctx, or "".
+func FromContext(ctx context.Context) string {
+	id, _ := ctx.Value(requestIdKey{}).(string)
+	return id
+}
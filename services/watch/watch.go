@@ -0,0 +1,63 @@
+// Package watch runs the change streams of WatchArticles and WatchAuthors.
+//
+// Events are read from storage, which orders them by commit; notifications
+// only tell a stream when to read again. A stream that missed one still
+// sends every event on the next wake up, and a client resuming from the
+// last sequence it received gets exactly the events after it.
+package watch
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/uacademy/blogpost/article_service/services/grpcerr"
+	"github.com/uacademy/blogpost/article_service/storage"
+)
+
+// BatchSize bounds how many events one read returns.
+const BatchSize = 100
+
+// SendFunc sends the events after afterSequence, at most BatchSize of
+// them, and returns the sequence of the last one sent, or afterSequence
+// when there were none.
+type SendFunc func(ctx context.Context, afterSequence int64) (int64, error)
+
+// Run streams the events of entityType after afterSequence through send
+// until the client goes away. With afterSequence 0 it starts at the newest
+// event. When the notifier stops, on shutdown, it returns Unavailable so
+// clients reconnect to another replica and resume.
+func Run(ctx context.Context, stg storage.StorageI, notifier storage.ChangeNotifier, entityType string, afterSequence int64, send SendFunc) error {
+	// Subscribe before the first read, so no commit in between is missed.
+	wake, cancel := notifier.Subscribe(entityType)
+	defer cancel()
+
+	if afterSequence == 0 {
+		last, err := stg.ReadLastChangeSequence(ctx)
+		if err != nil {
+			return grpcerr.FromStorage(err, "s.stg.ReadLastChangeSequence")
+		}
+		afterSequence = last
+	}
+
+	for {
+		last, err := send(ctx, afterSequence)
+		if err != nil {
+			return err
+		}
+		if last != afterSequence {
+			afterSequence = last
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		case _, ok := <-wake:
+			if !ok {
+				return status.Error(codes.Unavailable, "server is shutting down, resume on another connection")
+			}
+		}
+	}
+}
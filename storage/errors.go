@@ -0,0 +1,67 @@
+package storage
+
+import "errors"
+
+// Kinds of storage errors, to be matched with errors.Is.
+var (
+	ErrNotFound        = errors.New("not found")
+	ErrAlreadyExists   = errors.New("already exists")
+	ErrForeignKey      = errors.New("referenced resource does not exist")
+	ErrConflict        = errors.New("conflict")
+	ErrInvalidState    = errors.New("invalid state")
+	ErrInvalidArgument = errors.New("invalid argument")
+	ErrPermission      = errors.New("permission denied")
+)
+
+// Error is returned by StorageI implementations for failures the caller
+// can act on. Anything else is an internal error.
+type Error struct {
+	Kind     error
+	Resource string // e.g. "article", "author"
+	Name     string // id or other key of the resource, when known
+	Field    string // request field at fault, when known
+	Reason   string // machine readable cause of a permission error
+	Message  string
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+func (e *Error) Unwrap() error {
+	return e.Kind
+}
+
+func NotFound(resource, name string) error {
+	return &Error{Kind: ErrNotFound, Resource: resource, Name: name, Message: resource + " not found"}
+}
+
+func AlreadyExists(resource, field string) error {
+	return &Error{Kind: ErrAlreadyExists, Resource: resource, Field: field, Message: resource + " with this " + field + " already exists"}
+}
+
+func ForeignKey(resource, name, field string) error {
+	return &Error{Kind: ErrForeignKey, Resource: resource, Name: name, Field: field, Message: resource + " referenced by " + field + " does not exist"}
+}
+
+func Conflict(resource, message string) error {
+	return &Error{Kind: ErrConflict, Resource: resource, Message: message}
+}
+
+func InvalidState(resource, name, message string) error {
+	return &Error{Kind: ErrInvalidState, Resource: resource, Name: name, Message: message}
+}
+
+func InvalidArgument(field, message string) error {
+	return &Error{Kind: ErrInvalidArgument, Field: field, Message: message}
+}
+
+// Canceled reports a call cut short by its context. The Kind is the
+// context error, context.DeadlineExceeded or context.Canceled.
+func Canceled(cause error) error {
+	return &Error{Kind: cause, Message: cause.Error()}
+}
+
+func PermissionDenied(resource, name, reason, message string) error {
+	return &Error{Kind: ErrPermission, Resource: resource, Name: name, Reason: reason, Message: message}
+}
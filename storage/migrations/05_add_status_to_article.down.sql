@@ -0,0 +1,3 @@
+ALTER TABLE article DROP CONSTRAINT IF EXISTS chk_article_status;
+ALTER TABLE article DROP COLUMN IF EXISTS published_at;
+ALTER TABLE article DROP COLUMN IF EXISTS status;
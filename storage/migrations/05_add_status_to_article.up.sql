@@ -0,0 +1,7 @@
+ALTER TABLE article ADD COLUMN status VARCHAR(16) NOT NULL DEFAULT 'published';
+ALTER TABLE article ADD COLUMN published_at TIMESTAMP;
+
+UPDATE article SET published_at = created_at;
+
+ALTER TABLE article ALTER COLUMN status SET DEFAULT 'draft';
+ALTER TABLE article ADD CONSTRAINT chk_article_status CHECK (status IN ('draft', 'published', 'archived'));
@@ -0,0 +1,12 @@
+CREATE TABLE article_revision (
+    article_id CHAR(36) NOT NULL REFERENCES article (id),
+    revision INT NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    body TEXT NOT NULL,
+    edited_by VARCHAR(255) NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (article_id, revision)
+);
+
+INSERT INTO article_revision (article_id, revision, title, body, edited_by, created_at)
+SELECT id, 1, title, body, COALESCE(author_id, ''), COALESCE(updated_at, created_at, NOW()) FROM article;
@@ -0,0 +1,13 @@
+CREATE TABLE tag (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(64) UNIQUE NOT NULL,
+    created_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE TABLE article_tag (
+    article_id CHAR(36) NOT NULL REFERENCES article (id),
+    tag_id INT NOT NULL REFERENCES tag (id),
+    PRIMARY KEY (article_id, tag_id)
+);
+
+CREATE INDEX idx_article_tag_tag_id ON article_tag (tag_id);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS article_slug_redirect;
+DROP INDEX IF EXISTS idx_article_slug;
+ALTER TABLE article DROP COLUMN IF EXISTS slug;
@@ -0,0 +1,19 @@
+ALTER TABLE article ADD COLUMN slug VARCHAR(255);
+
+UPDATE article a SET slug = s.slug FROM (
+    SELECT id, CASE WHEN row_number() OVER (PARTITION BY base ORDER BY created_at, id) = 1
+        THEN base ELSE base || '-' || left(id, 8) END AS slug
+    FROM (
+        SELECT id, created_at, COALESCE(NULLIF(trim(BOTH '-' FROM regexp_replace(lower(title), '[^a-z0-9]+', '-', 'g')), ''), 'article') AS base
+        FROM article
+    ) b
+) s WHERE a.id = s.id;
+
+ALTER TABLE article ALTER COLUMN slug SET NOT NULL;
+CREATE UNIQUE INDEX idx_article_slug ON article (slug);
+
+CREATE TABLE article_slug_redirect (
+    slug VARCHAR(255) PRIMARY KEY,
+    article_id CHAR(36) NOT NULL REFERENCES article (id),
+    created_at TIMESTAMP DEFAULT NOW()
+);
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_article_created_at_id;
+DROP INDEX IF EXISTS idx_author_created_at_id;
+
+ALTER TABLE article ALTER COLUMN created_at DROP NOT NULL;
+ALTER TABLE author ALTER COLUMN created_at DROP NOT NULL;
@@ -0,0 +1,8 @@
+UPDATE author SET created_at = NOW() WHERE created_at IS NULL;
+UPDATE article SET created_at = NOW() WHERE created_at IS NULL;
+
+ALTER TABLE author ALTER COLUMN created_at SET NOT NULL;
+ALTER TABLE article ALTER COLUMN created_at SET NOT NULL;
+
+CREATE INDEX idx_author_created_at_id ON author (created_at, id) WHERE deleted_at IS NULL;
+CREATE INDEX idx_article_created_at_id ON article (created_at, id) WHERE deleted_at IS NULL;
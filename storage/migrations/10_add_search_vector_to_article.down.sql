@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_article_search_vector;
+DROP TRIGGER IF EXISTS trg_article_search_vector ON article;
+DROP FUNCTION IF EXISTS article_search_vector_update();
+ALTER TABLE article DROP COLUMN IF EXISTS search_vector;
+ALTER TABLE article DROP COLUMN IF EXISTS language;
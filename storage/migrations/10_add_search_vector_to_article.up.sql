@@ -0,0 +1,20 @@
+ALTER TABLE article ADD COLUMN language VARCHAR(32) NOT NULL DEFAULT 'english';
+ALTER TABLE article ADD COLUMN search_vector TSVECTOR;
+
+CREATE FUNCTION article_search_vector_update() RETURNS TRIGGER AS $$
+BEGIN
+    NEW.search_vector :=
+        setweight(to_tsvector(NEW.language::regconfig, coalesce(NEW.title, '')), 'A') ||
+        setweight(to_tsvector(NEW.language::regconfig, coalesce(NEW.body, '')), 'B');
+    RETURN NEW;
+END
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_article_search_vector BEFORE INSERT OR UPDATE OF title, body, language ON article
+    FOR EACH ROW EXECUTE PROCEDURE article_search_vector_update();
+
+UPDATE article SET search_vector =
+    setweight(to_tsvector(language::regconfig, coalesce(title, '')), 'A') ||
+    setweight(to_tsvector(language::regconfig, coalesce(body, '')), 'B');
+
+CREATE INDEX idx_article_search_vector ON article USING GIN (search_vector);
@@ -0,0 +1,2 @@
+ALTER TABLE author DROP COLUMN IF EXISTS version;
+ALTER TABLE article DROP COLUMN IF EXISTS version;
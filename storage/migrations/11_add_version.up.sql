@@ -0,0 +1,2 @@
+ALTER TABLE article ADD COLUMN version BIGINT NOT NULL DEFAULT 1;
+ALTER TABLE author ADD COLUMN version BIGINT NOT NULL DEFAULT 1;
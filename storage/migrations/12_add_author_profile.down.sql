@@ -0,0 +1,15 @@
+ALTER TABLE author DROP CONSTRAINT IF EXISTS chk_author_name;
+
+ALTER TABLE author ADD COLUMN fullname_plain VARCHAR(255);
+UPDATE author SET fullname_plain = fullname;
+ALTER TABLE author DROP COLUMN fullname;
+ALTER TABLE author RENAME COLUMN fullname_plain TO fullname;
+
+ALTER TABLE author DROP COLUMN IF EXISTS social_links;
+ALTER TABLE author DROP COLUMN IF EXISTS website;
+ALTER TABLE author DROP COLUMN IF EXISTS avatar_url;
+ALTER TABLE author DROP COLUMN IF EXISTS bio;
+ALTER TABLE author DROP COLUMN IF EXISTS display_name;
+ALTER TABLE author DROP COLUMN IF EXISTS middle_name;
+ALTER TABLE author DROP COLUMN IF EXISTS family_name;
+ALTER TABLE author DROP COLUMN IF EXISTS given_name;
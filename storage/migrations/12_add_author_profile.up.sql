@@ -0,0 +1,21 @@
+ALTER TABLE author ADD COLUMN given_name VARCHAR(100) NOT NULL DEFAULT '';
+ALTER TABLE author ADD COLUMN family_name VARCHAR(100) NOT NULL DEFAULT '';
+ALTER TABLE author ADD COLUMN middle_name VARCHAR(100) NOT NULL DEFAULT '';
+ALTER TABLE author ADD COLUMN display_name VARCHAR(255) NOT NULL DEFAULT '';
+ALTER TABLE author ADD COLUMN bio TEXT NOT NULL DEFAULT '';
+ALTER TABLE author ADD COLUMN avatar_url VARCHAR(2048) NOT NULL DEFAULT '';
+ALTER TABLE author ADD COLUMN website VARCHAR(2048) NOT NULL DEFAULT '';
+ALTER TABLE author ADD COLUMN social_links JSONB NOT NULL DEFAULT '[]';
+
+-- Existing names can not be split reliably, so they become display names.
+UPDATE author SET display_name = COALESCE(fullname, '');
+
+ALTER TABLE author DROP COLUMN fullname;
+ALTER TABLE author ADD COLUMN fullname TEXT GENERATED ALWAYS AS (
+    CASE WHEN display_name <> '' THEN display_name
+    ELSE btrim(regexp_replace(given_name || ' ' || middle_name || ' ' || family_name, '\s+', ' ', 'g'))
+    END
+) STORED;
+
+ALTER TABLE author ADD CONSTRAINT chk_author_name
+    CHECK (display_name <> '' OR given_name <> '' OR family_name <> '') NOT VALID;
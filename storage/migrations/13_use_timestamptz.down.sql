@@ -0,0 +1,14 @@
+ALTER TABLE article_slug_redirect ALTER COLUMN created_at TYPE TIMESTAMP;
+ALTER TABLE tag ALTER COLUMN created_at TYPE TIMESTAMP;
+ALTER TABLE article_revision ALTER COLUMN created_at TYPE TIMESTAMP;
+
+ALTER TABLE article
+    ALTER COLUMN created_at TYPE TIMESTAMP,
+    ALTER COLUMN updated_at TYPE TIMESTAMP,
+    ALTER COLUMN deleted_at TYPE TIMESTAMP,
+    ALTER COLUMN published_at TYPE TIMESTAMP;
+
+ALTER TABLE author
+    ALTER COLUMN created_at TYPE TIMESTAMP,
+    ALTER COLUMN updated_at TYPE TIMESTAMP,
+    ALTER COLUMN deleted_at TYPE TIMESTAMP;
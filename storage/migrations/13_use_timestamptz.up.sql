@@ -0,0 +1,16 @@
+-- Existing values were written by NOW() in the server's time zone, which
+-- is also the zone the conversion assumes.
+ALTER TABLE author
+    ALTER COLUMN created_at TYPE TIMESTAMPTZ,
+    ALTER COLUMN updated_at TYPE TIMESTAMPTZ,
+    ALTER COLUMN deleted_at TYPE TIMESTAMPTZ;
+
+ALTER TABLE article
+    ALTER COLUMN created_at TYPE TIMESTAMPTZ,
+    ALTER COLUMN updated_at TYPE TIMESTAMPTZ,
+    ALTER COLUMN deleted_at TYPE TIMESTAMPTZ,
+    ALTER COLUMN published_at TYPE TIMESTAMPTZ;
+
+ALTER TABLE article_revision ALTER COLUMN created_at TYPE TIMESTAMPTZ;
+ALTER TABLE tag ALTER COLUMN created_at TYPE TIMESTAMPTZ;
+ALTER TABLE article_slug_redirect ALTER COLUMN created_at TYPE TIMESTAMPTZ;
@@ -0,0 +1,10 @@
+CREATE TABLE user_author (
+    user_id VARCHAR(255) NOT NULL,
+    author_id CHAR(36) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT user_author_pkey PRIMARY KEY (user_id, author_id),
+    -- Links go away with the author when it is purged.
+    CONSTRAINT fk_user_author_author FOREIGN KEY (author_id) REFERENCES author (id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_user_author_author_id ON user_author (author_id);
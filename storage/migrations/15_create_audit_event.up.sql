@@ -0,0 +1,16 @@
+-- Audit events outlive the rows they describe, so there are no foreign keys.
+CREATE TABLE audit_event (
+    id BIGSERIAL PRIMARY KEY,
+    actor VARCHAR(255) NOT NULL DEFAULT '',
+    rpc VARCHAR(255) NOT NULL,
+    entity_type VARCHAR(50) NOT NULL,
+    entity_id VARCHAR(255) NOT NULL,
+    before JSONB,
+    after JSONB,
+    request_id VARCHAR(255) NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_audit_event_entity ON audit_event (entity_type, entity_id, id);
+CREATE INDEX idx_audit_event_actor ON audit_event (actor, id);
+CREATE INDEX idx_audit_event_created_at ON audit_event (created_at);
@@ -0,0 +1,4 @@
+DROP TRIGGER IF EXISTS trg_author_change_event ON author;
+DROP TRIGGER IF EXISTS trg_article_change_event ON article;
+DROP FUNCTION IF EXISTS record_change_event();
+DROP TABLE IF EXISTS change_event;
@@ -0,0 +1,56 @@
+-- change_event feeds WatchArticles and WatchAuthors. The triggers are
+-- deferred to commit and serialize on an advisory lock there, so seq is
+-- assigned in commit order and a reader resuming after a seq can not miss
+-- a transaction that committed later with a lower one.
+CREATE TABLE change_event (
+    seq BIGSERIAL PRIMARY KEY,
+    entity_type VARCHAR(50) NOT NULL,
+    entity_id CHAR(36) NOT NULL,
+    change_type VARCHAR(20) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_change_event_entity_type_seq ON change_event (entity_type, seq);
+
+CREATE FUNCTION record_change_event() RETURNS TRIGGER AS $$
+DECLARE
+    _change_type TEXT;
+    _entity_id CHAR(36);
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        _change_type := 'created';
+        _entity_id := NEW.id;
+    ELSIF TG_OP = 'DELETE' THEN
+        -- Purging a soft-deleted row was already reported as a delete.
+        IF OLD.deleted_at IS NOT NULL THEN
+            RETURN NULL;
+        END IF;
+        _change_type := 'deleted';
+        _entity_id := OLD.id;
+    ELSE
+        IF OLD.deleted_at IS NULL AND NEW.deleted_at IS NOT NULL THEN
+            _change_type := 'deleted';
+        ELSIF OLD.deleted_at IS NOT NULL AND NEW.deleted_at IS NULL THEN
+            _change_type := 'created';
+        ELSIF NEW.deleted_at IS NULL AND NEW IS DISTINCT FROM OLD THEN
+            _change_type := 'updated';
+        ELSE
+            RETURN NULL;
+        END IF;
+        _entity_id := NEW.id;
+    END IF;
+
+    PERFORM pg_advisory_xact_lock(hashtext('change_event'));
+    INSERT INTO change_event (entity_type, entity_id, change_type) VALUES (TG_ARGV[0], _entity_id, _change_type);
+    PERFORM pg_notify('change_event', TG_ARGV[0]);
+    RETURN NULL;
+END
+$$ LANGUAGE plpgsql;
+
+CREATE CONSTRAINT TRIGGER trg_article_change_event AFTER INSERT OR UPDATE OR DELETE ON article
+    DEFERRABLE INITIALLY DEFERRED
+    FOR EACH ROW EXECUTE PROCEDURE record_change_event('article');
+
+CREATE CONSTRAINT TRIGGER trg_author_change_event AFTER INSERT OR UPDATE OR DELETE ON author
+    DEFERRABLE INITIALLY DEFERRED
+    FOR EACH ROW EXECUTE PROCEDURE record_change_event('author');
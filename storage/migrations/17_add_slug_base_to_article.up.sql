@@ -0,0 +1,6 @@
+-- slug_base is the slug made from the title, before a de-duplication
+-- suffix was added, so a title ending in a number is not taken for a
+-- suffixed variant of a shorter one. Existing slugs are their own base.
+ALTER TABLE article ADD COLUMN slug_base VARCHAR(255);
+UPDATE article SET slug_base = slug;
+ALTER TABLE article ALTER COLUMN slug_base SET NOT NULL;
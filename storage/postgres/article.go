@@ -1,149 +1,586 @@
 package postgres
 
 import (
-	"errors"
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
 	"time"
+	"unicode/utf8"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 
 	"github.com/uacademy/blogpost/article_service/proto-gen/blogpost"
+	"github.com/uacademy/blogpost/article_service/storage"
 )
 
-func (stg Postgres) AddArticle(id string, input *blogpost.CreateArticleRequest) error {
-	_, err := stg.ReadAuthorById(input.AuthorId)
-	if err != nil {
-		return err
+var articleStatusNames = map[blogpost.ArticleStatus]string{
+	blogpost.ArticleStatus_ARTICLE_STATUS_DRAFT:     "draft",
+	blogpost.ArticleStatus_ARTICLE_STATUS_PUBLISHED: "published",
+	blogpost.ArticleStatus_ARTICLE_STATUS_ARCHIVED:  "archived",
+}
+
+// articleStatusSources lists the statuses an article may be moved from
+// into the key status.
+var articleStatusSources = map[blogpost.ArticleStatus][]string{
+	blogpost.ArticleStatus_ARTICLE_STATUS_DRAFT:     {"published"},
+	blogpost.ArticleStatus_ARTICLE_STATUS_PUBLISHED: {"draft", "archived"},
+	blogpost.ArticleStatus_ARTICLE_STATUS_ARCHIVED:  {"draft", "published"},
+}
+
+func articleStatusFromName(name string) blogpost.ArticleStatus {
+	for status, n := range articleStatusNames {
+		if n == name {
+			return status
+		}
 	}
+	return blogpost.ArticleStatus_ARTICLE_STATUS_UNSPECIFIED
+}
 
+func (stg Postgres) AddArticle(ctx context.Context, id string, input *blogpost.CreateArticleRequest, editedBy string) error {
 	if input.Content == nil{
 		input.Content = &blogpost.Content{}
 	}
 
-	_, err = stg.db.Exec(`INSERT INTO article (id, title, body, author_id) VALUES ($1, $2, $3, $4)`, id, input.Content.Title, input.Content.Body, input.AuthorId)
-	if err != nil {
-		return err
-	}
-	return nil
+	return stg.inTx(ctx, func(tx *sqlx.Tx) error {
+		// FOR SHARE keeps the author from being deleted until we commit.
+		var authorExists bool
+		err := tx.GetContext(ctx, &authorExists, `SELECT true FROM author WHERE id = $1 AND deleted_at IS NULL FOR SHARE`, input.AuthorId)
+		if err == sql.ErrNoRows {
+			return storage.ForeignKey("author", input.AuthorId, "author_id")
+		}
+		if err != nil {
+			return dbError(ctx, err)
+		}
+
+		language, err := searchLanguage("language", input.Language)
+		if err != nil {
+			return err
+		}
+
+		slugBase := makeSlug(input.Content.Title)
+		err = withUniqueSlug(ctx, tx, id, slugBase, func(slug string) error {
+			_, err := tx.ExecContext(ctx, `INSERT INTO article (id, title, body, author_id, slug, slug_base, language) VALUES ($1, $2, $3, $4, $5, $6, $7)`, id, input.Content.Title, input.Content.Body, input.AuthorId, slug, slugBase, language)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+
+		err = addArticleRevision(ctx, tx, id, input.Content, editedBy)
+		if err != nil {
+			return dbError(ctx, err)
+		}
+
+		return setArticleTags(ctx, tx, id, input.Tags)
+	})
 }
 
-func (stg Postgres) ReadArticleById(id string) (*blogpost.GetArticleByIdResponse, error) {
+func (stg Postgres) ReadArticleById(ctx context.Context, id string) (*blogpost.GetArticleByIdResponse, error) {
 	res := &blogpost.GetArticleByIdResponse{
 		Content: &blogpost.Content{},
 		Author: &blogpost.GetArticleByIdResponse_Author{},
 	}
-	var deletedAt *time.Time
-	var updatedAt, authorUpdatedAt *string
+	var createdAt, authorCreatedAt time.Time
+	var updatedAt, publishedAt, deletedAt, authorUpdatedAt *time.Time
+	var status string
 
-	err := stg.db.QueryRow(`SELECT
-		ar.id, ar.title, ar.body, ar.created_at, ar.updated_at, ar.deleted_at,
-		au.id, au.fullname, au.created_at, au.updated_at  
+	err := stg.q().QueryRowContext(ctx, `SELECT
+		ar.id, ar.title, ar.body, ar.slug, ar.language, ar.status, ar.published_at, ar.created_at, ar.updated_at, ar.deleted_at, ar.version,
+		au.id, au.fullname, au.created_at, au.updated_at,
+		ARRAY(SELECT t.name FROM article_tag at JOIN tag t ON t.id = at.tag_id WHERE at.article_id = ar.id ORDER BY t.name)
 		FROM article ar JOIN author au ON ar.author_id = au.id WHERE ar.id = $1`, id).Scan(
-		&res.Id, &res.Content.Title, &res.Content.Body, &res.CreatedAt, &updatedAt, &deletedAt, &res.Author.Id, &res.Author.Fullname, &res.Author.CreatedAt, &authorUpdatedAt,
+		&res.Id, &res.Content.Title, &res.Content.Body, &res.Slug, &res.Language, &status, &publishedAt, &createdAt, &updatedAt, &deletedAt, &res.Version, &res.Author.Id, &res.Author.Fullname, &authorCreatedAt, &authorUpdatedAt,
+		(*pq.StringArray)(&res.Tags),
 	)
+	if err == sql.ErrNoRows {
+		return nil, storage.NotFound("article", id)
+	}
 	if err != nil {
-		return res, err
+		return nil, dbError(ctx, err)
 	}
 
-	if updatedAt != nil{
-		res.UpdatedAt = *updatedAt
-	}
+	res.Status = articleStatusFromName(status)
 
-	if authorUpdatedAt != nil{
-		res.Author.UpdatedAt = *authorUpdatedAt
-	}
+	setTime(&createdAt, &res.CreatedAt, &res.CreateTime)
+	setTime(updatedAt, &res.UpdatedAt, &res.UpdateTime)
+	setTime(publishedAt, &res.PublishedAt, &res.PublishTime)
+	setTime(&authorCreatedAt, &res.Author.CreatedAt, &res.Author.CreateTime)
+	setTime(authorUpdatedAt, &res.Author.UpdatedAt, &res.Author.UpdateTime)
 
 	if deletedAt != nil{
-		return res, errors.New("article not found")
+		return nil, storage.NotFound("article", id)
 	}
 
 	return res, nil
 }
 
-func (stg Postgres) ReadListArticle(offset, limit int, search string) (*blogpost.GetArticleListResponse, error) {
+// ReadArticleBySlug finds an article by its current slug or by any slug
+// it had before.
+func (stg Postgres) ReadArticleBySlug(ctx context.Context, slug string) (*blogpost.GetArticleByIdResponse, error) {
+	var id string
+	err := stg.q().GetContext(ctx, &id, `SELECT id FROM article WHERE slug = $1
+	UNION ALL SELECT article_id FROM article_slug_redirect WHERE slug = $1
+	LIMIT 1`, slug)
+	if err == sql.ErrNoRows {
+		return nil, storage.NotFound("article", slug)
+	}
+	if err != nil {
+		return nil, dbError(ctx, err)
+	}
+
+	return stg.ReadArticleById(ctx, id)
+}
+
+// articleSort is a column GetArticleList can be sorted by.
+type articleSort struct {
+	expr  string
+	cast  string
+	value func(a *blogpost.Article) string
+}
+
+// articleSorts is the allow-list of sort_by values. Only these
+// expressions ever reach ORDER BY.
+var articleSorts = map[string]articleSort{
+	"created_at": {
+		expr:  "created_at",
+		cast:  "::timestamptz",
+		value: func(a *blogpost.Article) string { return cursorTime(a.CreateTime) },
+	},
+	"updated_at": {
+		expr: "COALESCE(updated_at, created_at)",
+		cast: "::timestamptz",
+		value: func(a *blogpost.Article) string {
+			if a.UpdateTime != nil {
+				return cursorTime(a.UpdateTime)
+			}
+			return cursorTime(a.CreateTime)
+		},
+	},
+	"title": {
+		expr:  "title",
+		value: func(a *blogpost.Article) string { return a.Content.Title },
+	},
+}
+
+// parseDateFilter accepts an RFC 3339 timestamp or a plain date.
+func parseDateFilter(name, value string) (time.Time, error) {
+	t, err := time.Parse(time.RFC3339, value)
+	if err == nil {
+		return t, nil
+	}
+
+	t, err = time.Parse("2006-01-02", value)
+	if err != nil {
+		return t, storage.InvalidArgument(name, "expected RFC 3339 timestamp or YYYY-MM-DD date")
+	}
+	return t, nil
+}
+
+func (stg Postgres) ReadListArticle(ctx context.Context, input *blogpost.GetArticleListRequest) (*blogpost.GetArticleListResponse, error) {
 	resp := &blogpost.GetArticleListResponse{
 		Articles: make([]*blogpost.Article, 0),
 	}
 
-	rows, err := stg.db.Queryx(`SELECT
+	var args queryArgs
+	where := "deleted_at IS NULL"
+
+	if input.Search != "" {
+		p := args.add(input.Search)
+		where += " AND ((title ILIKE '%' || " + p + " || '%') OR (body ILIKE '%' || " + p + " || '%'))"
+	}
+
+	if name, ok := articleStatusNames[input.Status]; ok {
+		where += " AND status = " + args.add(name)
+	}
+
+	if input.AuthorId != "" {
+		where += " AND author_id = " + args.add(input.AuthorId)
+	}
+
+	dateFilters := []struct {
+		name, value, cond string
+	}{
+		{"created_from", input.CreatedFrom, "created_at >= "},
+		{"created_to", input.CreatedTo, "created_at < "},
+		{"updated_from", input.UpdatedFrom, "updated_at >= "},
+		{"updated_to", input.UpdatedTo, "updated_at < "},
+	}
+	for _, f := range dateFilters {
+		if f.value == "" {
+			continue
+		}
+		t, err := parseDateFilter(f.name, f.value)
+		if err != nil {
+			return resp, err
+		}
+		where += " AND " + f.cond + args.add(t)
+	}
+
+	sortBy := input.SortBy
+	if sortBy == "" {
+		sortBy = "created_at"
+	}
+	sort, ok := articleSorts[sortBy]
+	if !ok {
+		return resp, storage.InvalidArgument("sort_by", "expected created_at, updated_at or title")
+	}
+
+	order, cmp := "ASC", ">"
+	switch input.SortOrder {
+	case "", "asc":
+	case "desc":
+		order, cmp = "DESC", "<"
+	default:
+		return resp, storage.InvalidArgument("sort_order", "expected asc or desc")
+	}
+	sortKey := sortBy + " " + order
+
+	if tags := normalizeTags(input.Tags); len(tags) > 0 {
+		having := ""
+		if input.TagMatch == blogpost.TagMatch_TAG_MATCH_ALL {
+			having = " HAVING COUNT(*) = " + args.add(len(tags))
+		}
+		where += ` AND id IN (
+		SELECT at.article_id FROM article_tag at JOIN tag t ON t.id = at.tag_id
+		WHERE t.name = ANY(` + args.add(pq.Array(tags)) + `)
+		GROUP BY at.article_id` + having + `)`
+	}
+
+	if input.IncludeTotalCount {
+		err := stg.q().GetContext(ctx, &resp.TotalCount, `SELECT COUNT(*) FROM article WHERE `+where, args...)
+		if err != nil {
+			return resp, dbError(ctx, err)
+		}
+	}
+
+	if input.PageToken != "" {
+		cursor, err := decodePageToken(input.PageToken, sortKey)
+		if err != nil {
+			return resp, err
+		}
+		where += " AND (" + sort.expr + ", id) " + cmp + " (" + args.add(cursor.Value) + sort.cast + ", " + args.add(cursor.Id) + ")"
+	}
+
+	query := `SELECT
 	id,
 	title,
 	body,
+	slug,
+	language,
 	author_id,
+	status,
+	published_at,
 	created_at,
-	updated_at
-	FROM article WHERE deleted_at IS NULL AND ((title ILIKE '%' || $1 || '%') OR (body ILIKE '%' || $1 || '%'))
-	LIMIT $2
-	OFFSET $3
-	`, search, limit, offset)
+	updated_at,
+	version,
+	ARRAY(SELECT t.name FROM article_tag at JOIN tag t ON t.id = at.tag_id WHERE at.article_id = article.id ORDER BY t.name)
+	FROM article WHERE ` + where + `
+	ORDER BY ` + sort.expr + ` ` + order + `, id ` + order + `
+	LIMIT ` + args.add(input.Limit+1)
+
+	if input.PageToken == "" {
+		query += " OFFSET " + args.add(input.Offset)
+	}
 
+	rows, err := stg.q().QueryxContext(ctx, query, args...)
 	if err != nil {
-		return resp, err
+		return resp, dbError(ctx, err)
 	}
+	defer rows.Close()
+
 	for rows.Next() {
 		a := &blogpost.Article{
 			Content: &blogpost.Content{},
 		}
 
-		var updatedAt *string
+		var createdAt time.Time
+		var updatedAt, publishedAt *time.Time
+		var status string
 
 		err := rows.Scan(
 			&a.Id,
 			&a.Content.Title,
 			&a.Content.Body,
+			&a.Slug,
+			&a.Language,
 			&a.AuthorId,
-			&a.CreatedAt,
+			&status,
+			&publishedAt,
+			&createdAt,
 			&updatedAt,
+			&a.Version,
+			(*pq.StringArray)(&a.Tags),
 		)
 		if err != nil {
-			return resp, err
+			return resp, dbError(ctx, err)
 		}
 
-		if updatedAt != nil{
-			a.UpdatedAt = *updatedAt
-		}
+		a.Status = articleStatusFromName(status)
+
+		setTime(&createdAt, &a.CreatedAt, &a.CreateTime)
+		setTime(updatedAt, &a.UpdatedAt, &a.UpdateTime)
+		setTime(publishedAt, &a.PublishedAt, &a.PublishTime)
 		resp.Articles = append(resp.Articles, a)
 	}
+	if err := rows.Err(); err != nil {
+		return resp, dbError(ctx, err)
+	}
+
+	if len(resp.Articles) > int(input.Limit) {
+		resp.Articles = resp.Articles[:input.Limit]
+		last := resp.Articles[len(resp.Articles)-1]
+		resp.NextPageToken = encodePageToken(pageCursor{Sort: sortKey, Value: sort.value(last), Id: last.Id})
+	}
 
-	return resp, err
+	return resp, nil
 }
 
-func (stg Postgres) UpdateArticle(input *blogpost.UpdateArticleRequest) error {
-	if input.Content == nil{
-		input.Content = &blogpost.Content{}
+// articleUpdateFields maps the update_mask paths UpdateArticle accepts to
+// the fields they write.
+var articleUpdateFields = map[string][]string{
+	"content":       {"content.title", "content.body"},
+	"content.title": {"content.title"},
+	"content.body":  {"content.body"},
+	"tags":          {"tags"},
+	"language":      {"language"},
+}
+
+func (stg Postgres) UpdateArticle(ctx context.Context, input *blogpost.UpdateArticleRequest) error {
+	fields, err := articleUpdateMask(input)
+	if err != nil {
+		return err
 	}
 
-	res, err := stg.db.NamedExec("UPDATE article  SET title=:t, body=:b, updated_at=now() WHERE deleted_at IS NULL AND id=:id", map[string]interface{}{
-		"id": input.Id,
-		"t":  input.Content.Title,
-		"b":  input.Content.Body,
+	return stg.inTx(ctx, func(tx *sqlx.Tx) error {
+		return updateArticle(ctx, tx, input, fields)
 	})
+}
+
+// articleUpdateMask resolves the fields an update writes. Without a mask
+// that is the content, and the language when it is set. Tags are only
+// replaced when the mask names them, as older clients do not send them,
+// and tags sent without a mask are rejected rather than dropped.
+func articleUpdateMask(input *blogpost.UpdateArticleRequest) (fieldSet, error) {
+	if len(input.UpdateMask.GetPaths()) == 0 && len(input.Tags) > 0 {
+		return nil, storage.InvalidArgument("update_mask", `tags are only replaced when update_mask names "tags"`)
+	}
+
+	defaults := []string{"content"}
+	if input.Language != "" {
+		defaults = append(defaults, "language")
+	}
+
+	return maskPaths(input.UpdateMask, articleUpdateFields, defaults...)
+}
+
+// updateArticle writes the given fields of input onto the article. A
+// content change is recorded as a new revision holding the merged content.
+func updateArticle(ctx context.Context, tx *sqlx.Tx, input *blogpost.UpdateArticleRequest, fields fieldSet) error {
+	content := input.Content
+	if content == nil {
+		content = &blogpost.Content{}
+	}
+
+	if fields["content.title"] {
+		if n := utf8.RuneCountInString(content.Title); n < 1 || n > 255 {
+			return storage.InvalidArgument("content.title", "title must be between 1 and 255 characters")
+		}
+	}
+	if fields["content.body"] && content.Body == "" {
+		return storage.InvalidArgument("content.body", "body must not be empty")
+	}
+
+	var current struct {
+		Title    string
+		Body     string
+		Slug     string
+		SlugBase string `db:"slug_base"`
+		Version  int64
+	}
+	err := tx.GetContext(ctx, &current, `SELECT title, body, slug, slug_base, version FROM article WHERE deleted_at IS NULL AND id = $1 FOR UPDATE`, input.Id)
+	if err == sql.ErrNoRows {
+		return storage.NotFound("article", input.Id)
+	}
+	if err != nil {
+		return dbError(ctx, err)
+	}
+
+	err = checkVersion("article", input.Id, current.Version, input.ExpectedVersion)
 	if err != nil {
 		return err
 	}
 
+	var args queryArgs
+	set := []string{"version=version+1", "updated_at=now()"}
+
+	next := &blogpost.Content{Title: current.Title, Body: current.Body}
+	contentChanged := fields["content.title"] || fields["content.body"]
+	if contentChanged {
+		if fields["content.title"] {
+			next.Title = content.Title
+		}
+		if fields["content.body"] {
+			next.Body = content.Body
+		}
+
+		slug, slugBase, err := changeArticleSlug(ctx, tx, input.Id, current.Slug, current.SlugBase, next.Title)
+		if err != nil {
+			return dbError(ctx, err)
+		}
+		set = append(set, "title="+args.add(next.Title), "body="+args.add(next.Body), "slug="+args.add(slug), "slug_base="+args.add(slugBase))
+	}
+
+	if fields["language"] {
+		language, err := searchLanguage("language", input.Language)
+		if err != nil {
+			return err
+		}
+		set = append(set, "language="+args.add(language))
+	}
+
+	_, err = tx.ExecContext(ctx, `UPDATE article SET `+strings.Join(set, ", ")+` WHERE id = `+args.add(input.Id), args...)
+	if err != nil {
+		return dbError(ctx, err)
+	}
+
+	if contentChanged {
+		err = addArticleRevision(ctx, tx, input.Id, next, input.EditedBy)
+		if err != nil {
+			return dbError(ctx, err)
+		}
+	}
+
+	if fields["tags"] {
+		return setArticleTags(ctx, tx, input.Id, input.Tags)
+	}
+	return nil
+}
+
+func (stg Postgres) DeleteArticle(ctx context.Context, id string, expectedVersion int64) error {
+	res, err := stg.q().ExecContext(ctx, `UPDATE article SET deleted_at=now(), version=version+1
+	WHERE id=$1 AND deleted_at IS NULL AND ($2 = 0 OR version = $2)`, id, expectedVersion)
+	if err != nil {
+		return dbError(ctx, err)
+	}
+
 	n, err := res.RowsAffected()
 	if err != nil {
-		return err
+		return dbError(ctx, err)
 	}
 
 	if n > 0 {
 		return nil
 	}
-
-	return errors.New("article not found")
+	return missedRowError(ctx, stg.q(), "article", id, expectedVersion)
 }
 
-func (stg Postgres) DeleteArticle(id string) error {
-	res, err := stg.db.Exec("UPDATE article  SET deleted_at=now() WHERE id=$1 AND deleted_at IS NULL", id)
+// SetArticleStatus moves an article into status. Publishing stamps
+// published_at, moving back to draft clears it.
+func (stg Postgres) SetArticleStatus(ctx context.Context, id string, status blogpost.ArticleStatus) error {
+	sources, ok := articleStatusSources[status]
+	if !ok {
+		return storage.InvalidArgument("status", fmt.Sprintf("unsupported article status %s", status))
+	}
+
+	res, err := stg.q().ExecContext(ctx, `UPDATE article SET
+	status=$2,
+	published_at=CASE $2 WHEN 'published' THEN now() WHEN 'draft' THEN NULL ELSE published_at END,
+	version=version+1,
+	updated_at=now()
+	WHERE id=$1 AND deleted_at IS NULL AND status = ANY($3)`, id, articleStatusNames[status], pq.Array(sources))
 	if err != nil {
-		return err
+		return dbError(ctx, err)
 	}
 
 	n, err := res.RowsAffected()
 	if err != nil {
-		return err
+		return dbError(ctx, err)
 	}
 
 	if n > 0 {
 		return nil
 	}
-	return errors.New("article not found")
+
+	var current string
+	err = stg.q().QueryRowContext(ctx, `SELECT status FROM article WHERE id=$1 AND deleted_at IS NULL`, id).Scan(&current)
+	if err == sql.ErrNoRows {
+		return storage.NotFound("article", id)
+	}
+	if err != nil {
+		return dbError(ctx, err)
+	}
+
+	return storage.InvalidState("article", id, fmt.Sprintf("article can not be moved from %s to %s", current, articleStatusNames[status]))
+}
+
+func (stg Postgres) ReadArticleAuthorId(ctx context.Context, id string) (string, error) {
+	var authorId sql.NullString
+	err := stg.q().GetContext(ctx, &authorId, `SELECT author_id FROM article WHERE id = $1`, id)
+	if err == sql.ErrNoRows {
+		return "", storage.NotFound("article", id)
+	}
+	if err != nil {
+		return "", dbError(ctx, err)
+	}
+	return authorId.String, nil
+}
+
+// articleRowSelect lists the columns of the article table scanArticleRow
+// reads, deleted rows included.
+const articleRowSelect = `id,
+	title,
+	body,
+	slug,
+	language,
+	author_id,
+	status,
+	published_at,
+	created_at,
+	updated_at,
+	deleted_at,
+	version,
+	ARRAY(SELECT t.name FROM article_tag at JOIN tag t ON t.id = at.tag_id WHERE at.article_id = article.id ORDER BY t.name)`
+
+func scanArticleRow(ctx context.Context, rows *sqlx.Rows) (*blogpost.Article, error) {
+	a := &blogpost.Article{
+		Content: &blogpost.Content{},
+	}
+
+	var authorId *string
+	var createdAt time.Time
+	var updatedAt, publishedAt, deletedAt *time.Time
+	var status string
+
+	err := rows.Scan(
+		&a.Id,
+		&a.Content.Title,
+		&a.Content.Body,
+		&a.Slug,
+		&a.Language,
+		&authorId,
+		&status,
+		&publishedAt,
+		&createdAt,
+		&updatedAt,
+		&deletedAt,
+		&a.Version,
+		(*pq.StringArray)(&a.Tags),
+	)
+	if err != nil {
+		return nil, dbError(ctx, err)
+	}
+
+	a.Status = articleStatusFromName(status)
+
+	if authorId != nil {
+		a.AuthorId = *authorId
+	}
+
+	setTime(&createdAt, &a.CreatedAt, &a.CreateTime)
+	setTime(updatedAt, &a.UpdatedAt, &a.UpdateTime)
+	setTime(publishedAt, &a.PublishedAt, &a.PublishTime)
+	setTime(deletedAt, &a.DeletedAt, &a.DeleteTime)
+	return a, nil
 }
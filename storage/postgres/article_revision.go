@@ -0,0 +1,118 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/uacademy/blogpost/article_service/proto-gen/blogpost"
+	"github.com/uacademy/blogpost/article_service/storage"
+)
+
+// addArticleRevision appends content as the next revision of the article.
+// Callers update the article row first, so its row lock keeps concurrent
+// writers from picking the same revision number.
+func addArticleRevision(ctx context.Context, tx *sqlx.Tx, articleId string, content *blogpost.Content, editedBy string) error {
+	_, err := tx.ExecContext(ctx, `INSERT INTO article_revision (article_id, revision, title, body, edited_by)
+	SELECT $1, COALESCE(MAX(revision), 0) + 1, $2, $3, $4 FROM article_revision WHERE article_id = $1`,
+		articleId, content.Title, content.Body, editedBy)
+	return dbError(ctx, err)
+}
+
+func (stg Postgres) ReadArticleRevision(ctx context.Context, articleId string, revision int) (*blogpost.ArticleRevision, error) {
+	res := &blogpost.ArticleRevision{
+		Content: &blogpost.Content{},
+	}
+
+	var createdAt time.Time
+
+	err := stg.q().QueryRowContext(ctx, `SELECT article_id, revision, title, body, edited_by, created_at
+	FROM article_revision WHERE article_id = $1 AND revision = $2`, articleId, revision).Scan(
+		&res.ArticleId, &res.Revision, &res.Content.Title, &res.Content.Body, &res.EditedBy, &createdAt,
+	)
+	if err == sql.ErrNoRows {
+		return nil, storage.NotFound("article revision", fmt.Sprintf("%s/%d", articleId, revision))
+	}
+	if err != nil {
+		return nil, dbError(ctx, err)
+	}
+
+	setTime(&createdAt, &res.CreatedAt, &res.CreateTime)
+
+	return res, nil
+}
+
+func (stg Postgres) ReadListArticleRevision(ctx context.Context, articleId string, offset, limit int) (*blogpost.ListArticleRevisionsResponse, error) {
+	resp := &blogpost.ListArticleRevisionsResponse{
+		Revisions: make([]*blogpost.ArticleRevision, 0),
+	}
+
+	rows, err := stg.q().QueryxContext(ctx, `SELECT
+	article_id,
+	revision,
+	title,
+	body,
+	edited_by,
+	created_at
+	FROM article_revision WHERE article_id = $1
+	ORDER BY revision DESC
+	LIMIT $2
+	OFFSET $3
+	`, articleId, limit, offset)
+	if err != nil {
+		return resp, dbError(ctx, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		r := &blogpost.ArticleRevision{
+			Content: &blogpost.Content{},
+		}
+		var createdAt time.Time
+
+		err := rows.Scan(
+			&r.ArticleId,
+			&r.Revision,
+			&r.Content.Title,
+			&r.Content.Body,
+			&r.EditedBy,
+			&createdAt,
+		)
+		if err != nil {
+			return resp, dbError(ctx, err)
+		}
+
+		setTime(&createdAt, &r.CreatedAt, &r.CreateTime)
+
+		resp.Revisions = append(resp.Revisions, r)
+	}
+
+	return resp, dbError(ctx, rows.Err())
+}
+
+// RestoreArticleRevision copies an old revision back onto the article.
+// The restore itself is recorded as a new revision, so history is never
+// rewritten.
+func (stg Postgres) RestoreArticleRevision(ctx context.Context, articleId string, revision int, editedBy string) error {
+	return stg.inTx(ctx, func(tx *sqlx.Tx) error {
+		content := &blogpost.Content{}
+		err := tx.QueryRowContext(ctx, `SELECT title, body FROM article_revision WHERE article_id = $1 AND revision = $2`, articleId, revision).Scan(
+			&content.Title, &content.Body,
+		)
+		if err == sql.ErrNoRows {
+			return storage.NotFound("article revision", fmt.Sprintf("%s/%d", articleId, revision))
+		}
+		if err != nil {
+			return dbError(ctx, err)
+		}
+
+		return updateArticle(ctx, tx, &blogpost.UpdateArticleRequest{
+			Id:       articleId,
+			Content:  content,
+			EditedBy: editedBy,
+		}, fieldSet{"content.title": true, "content.body": true})
+	})
+}
@@ -0,0 +1,65 @@
+package postgres
+
+import (
+	"errors"
+	"testing"
+
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/uacademy/blogpost/article_service/proto-gen/blogpost"
+	"github.com/uacademy/blogpost/article_service/storage"
+)
+
+func TestArticleUpdateMaskKeepsTags(t *testing.T) {
+	fields, err := articleUpdateMask(&blogpost.UpdateArticleRequest{
+		Content: &blogpost.Content{Title: "title", Body: "body"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fields["tags"] {
+		t.Error("an update without a mask replaces the tags")
+	}
+	if !fields["content.title"] || !fields["content.body"] {
+		t.Errorf("an update without a mask does not write the content: %v", fields)
+	}
+
+}
+
+func TestArticleUpdateMaskRejectsTagsWithoutMask(t *testing.T) {
+	_, err := articleUpdateMask(&blogpost.UpdateArticleRequest{
+		Content: &blogpost.Content{Title: "title", Body: "body"},
+		Tags:    []string{"go"},
+	})
+	if !errors.Is(err, storage.ErrInvalidArgument) {
+		t.Errorf("got %v, want an invalid argument error", err)
+	}
+}
+
+func TestArticleUpdateMaskTags(t *testing.T) {
+	fields, err := articleUpdateMask(&blogpost.UpdateArticleRequest{
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"tags"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !fields["tags"] || fields["content.title"] || fields["content.body"] {
+		t.Errorf("got %v, want only tags", fields)
+	}
+}
+
+// TestArticleUpdateMaskRESTTags covers the mask the REST handler sends
+// along with tags.
+func TestArticleUpdateMaskRESTTags(t *testing.T) {
+	fields, err := articleUpdateMask(&blogpost.UpdateArticleRequest{
+		Content:    &blogpost.Content{Title: "title", Body: "body"},
+		Tags:       []string{"go"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content", "tags"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !fields["tags"] || !fields["content.title"] || !fields["content.body"] {
+		t.Errorf("got %v, want content and tags", fields)
+	}
+}
@@ -0,0 +1,114 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/uacademy/blogpost/article_service/proto-gen/blogpost"
+	"github.com/uacademy/blogpost/article_service/storage"
+)
+
+func (stg Postgres) AddAuditEvent(ctx context.Context, event *blogpost.AuditEvent) error {
+	_, err := stg.q().ExecContext(ctx, `INSERT INTO audit_event (actor, rpc, entity_type, entity_id, before, after, request_id)
+	VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+		event.Actor, event.Rpc, event.EntityType, event.EntityId, nullString(event.Before), nullString(event.After), event.RequestId)
+	return dbError(ctx, err)
+}
+
+func (stg Postgres) ReadListAuditEvent(ctx context.Context, input *blogpost.ListAuditEventsRequest) (*blogpost.ListAuditEventsResponse, error) {
+	resp := &blogpost.ListAuditEventsResponse{
+		Events: make([]*blogpost.AuditEvent, 0),
+	}
+
+	var args queryArgs
+	where := "true"
+
+	if input.EntityType != "" {
+		where += " AND entity_type = " + args.add(input.EntityType)
+	}
+	if input.EntityId != "" {
+		where += " AND entity_id = " + args.add(input.EntityId)
+	}
+	if input.Actor != "" {
+		where += " AND actor = " + args.add(input.Actor)
+	}
+	if input.From != nil {
+		where += " AND created_at >= " + args.add(input.From.AsTime())
+	}
+	if input.To != nil {
+		where += " AND created_at < " + args.add(input.To.AsTime())
+	}
+
+	if input.PageToken != "" {
+		cursor, err := decodePageToken(input.PageToken, "id")
+		if err != nil {
+			return resp, err
+		}
+		id, err := strconv.ParseInt(cursor.Id, 10, 64)
+		if err != nil {
+			return resp, storage.InvalidArgument("page_token", "invalid page token")
+		}
+		where += " AND id < " + args.add(id)
+	}
+
+	rows, err := stg.q().QueryxContext(ctx, `SELECT
+	id,
+	actor,
+	rpc,
+	entity_type,
+	entity_id,
+	COALESCE(before::text, ''),
+	COALESCE(after::text, ''),
+	request_id,
+	created_at
+	FROM audit_event WHERE `+where+`
+	ORDER BY id DESC
+	LIMIT `+args.add(input.Limit+1), args...)
+	if err != nil {
+		return resp, dbError(ctx, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		e := &blogpost.AuditEvent{}
+		var createdAt time.Time
+
+		err := rows.Scan(
+			&e.Id,
+			&e.Actor,
+			&e.Rpc,
+			&e.EntityType,
+			&e.EntityId,
+			&e.Before,
+			&e.After,
+			&e.RequestId,
+			&createdAt,
+		)
+		if err != nil {
+			return resp, dbError(ctx, err)
+		}
+
+		e.CreateTime = timestamppb.New(createdAt)
+		resp.Events = append(resp.Events, e)
+	}
+	if err := rows.Err(); err != nil {
+		return resp, dbError(ctx, err)
+	}
+
+	if len(resp.Events) > int(input.Limit) {
+		resp.Events = resp.Events[:input.Limit]
+		last := resp.Events[len(resp.Events)-1]
+		resp.NextPageToken = encodePageToken(pageCursor{Sort: "id", Id: strconv.FormatInt(last.Id, 10)})
+	}
+
+	return resp, nil
+}
+
+// nullString stores "" as NULL.
+func nullString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
@@ -1,114 +1,327 @@
 package postgres
 
 import (
+	"github.com/jmoiron/sqlx"
 	"github.com/uacademy/blogpost/article_service/proto-gen/blogpost"
+	"github.com/uacademy/blogpost/article_service/storage"
 
-	"errors"
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
 )
 
-func (stg Postgres) AddAuthor(id string, input *blogpost.CreateAuthorRequest) error {
-	_, err := stg.db.Exec(`INSERT INTO author (id, fullname) VALUES ($1, $2)`, id, input.Fullname)
+// AddAuthor stores a new author. A legacy fullname becomes the display
+// name unless the profile has one.
+func (stg Postgres) AddAuthor(ctx context.Context, id string, input *blogpost.CreateAuthorRequest) error {
+	if input.Profile == nil {
+		input.Profile = &blogpost.AuthorProfile{}
+	}
+	if input.Profile.DisplayName == "" {
+		input.Profile.DisplayName = input.Fullname
+	}
+	if !hasAuthorName(input.Profile) {
+		return storage.InvalidArgument("fullname", "fullname or a name in profile is required")
+	}
+
+	args := queryArgs{id}
+	values := make([]string, 0, len(authorProfileColumns))
+	for _, v := range authorProfileValues(input.Profile) {
+		values = append(values, args.add(v))
+	}
+
+	_, err := stg.q().ExecContext(ctx, `INSERT INTO author (id, `+authorProfileSelect+`) VALUES ($1, `+strings.Join(values, ", ")+`)`, args...)
 	if err != nil {
-		return err
+		return dbError(ctx, err)
 	}
 	return nil
 }
 
-func (stg Postgres) ReadAuthorById(id string) (*blogpost.GetAuthorByIdResponse, error) {
-	res := &blogpost.GetAuthorByIdResponse{}
-	var updatedAt *string
+func (stg Postgres) ReadAuthorById(ctx context.Context, id string) (*blogpost.GetAuthorByIdResponse, error) {
+	res := &blogpost.GetAuthorByIdResponse{
+		Profile: &blogpost.AuthorProfile{},
+	}
+	var createdAt time.Time
+	var updatedAt *time.Time
 
-	err := stg.db.QueryRow(`SELECT id, fullname, created_at, updated_at FROM author WHERE id=$1 AND deleted_at IS NULL`, id).Scan(
-		&res.Id, &res.Fullname, &res.CreatedAt, &updatedAt,
-	)
+	dest := append([]interface{}{&res.Id, &res.Fullname, &createdAt, &updatedAt, &res.Version}, authorProfileDest(res.Profile)...)
+	err := stg.q().QueryRowContext(ctx, `SELECT id, fullname, created_at, updated_at, version, `+authorProfileSelect+` FROM author WHERE id=$1 AND deleted_at IS NULL`, id).Scan(dest...)
 
-	if err != nil{
-		return nil, errors.New("author not found")
+	if err == sql.ErrNoRows {
+		return nil, storage.NotFound("author", id)
 	}
-
-	if updatedAt != nil{
-		res.UpdatedAt = *updatedAt
+	if err != nil {
+		return nil, dbError(ctx, err)
 	}
 
+	setTime(&createdAt, &res.CreatedAt, &res.CreateTime)
+	setTime(updatedAt, &res.UpdatedAt, &res.UpdateTime)
+
 	return res, nil
 }
 
-func (stg Postgres) ReadListAuthor(offset, limit int, search string) (*blogpost.GetAuthorListResponse, error) {
+func (stg Postgres) ReadListAuthor(ctx context.Context, input *blogpost.GetAuthorListRequest) (*blogpost.GetAuthorListResponse, error) {
 	resp := &blogpost.GetAuthorListResponse{
 		Authors: make([]*blogpost.Author, 0),
 	}
-	
-	rows, err := stg.db.Queryx(`SELECT
+
+	var args queryArgs
+	where := "deleted_at IS NULL"
+
+	if input.Search != "" {
+		where += " AND (fullname ILIKE '%' || " + args.add(input.Search) + " || '%')"
+	}
+
+	if input.IncludeTotalCount {
+		err := stg.q().GetContext(ctx, &resp.TotalCount, `SELECT COUNT(*) FROM author WHERE `+where, args...)
+		if err != nil {
+			return resp, dbError(ctx, err)
+		}
+	}
+
+	if input.PageToken != "" {
+		cursor, err := decodePageToken(input.PageToken, "created_at")
+		if err != nil {
+			return resp, err
+		}
+		where += " AND (created_at, id) > (" + args.add(cursor.Value) + "::timestamptz, " + args.add(cursor.Id) + ")"
+	}
+
+	query := `SELECT
 	id,
 	fullname,
 	created_at,
-	updated_at
-	FROM author WHERE deleted_at IS NULL AND (fullname ILIKE '%' || $1 || '%')
-	LIMIT $2
-	OFFSET $3
-	`, search, limit, offset)
+	updated_at,
+	version,
+	` + authorProfileSelect + `
+	FROM author WHERE ` + where + `
+	ORDER BY created_at, id
+	LIMIT ` + args.add(input.Limit+1)
 
+	if input.PageToken == "" {
+		query += " OFFSET " + args.add(input.Offset)
+	}
+
+	rows, err := stg.q().QueryxContext(ctx, query, args...)
 	if err != nil {
-		return resp, err
+		return resp, dbError(ctx, err)
 	}
+	defer rows.Close()
+
 	for rows.Next() {
-		a := &blogpost.Author{}
-		var updatedAt *string
+		a := &blogpost.Author{
+			Profile: &blogpost.AuthorProfile{},
+		}
+		var createdAt time.Time
+		var updatedAt *time.Time
 
-		err := rows.Scan(
+		dest := []interface{}{
 			&a.Id,
 			&a.Fullname,
-			&a.CreatedAt,
+			&createdAt,
 			&updatedAt,
-		)
+			&a.Version,
+		}
+		err := rows.Scan(append(dest, authorProfileDest(a.Profile)...)...)
 		if err != nil {
-			return resp, err
+			return resp, dbError(ctx, err)
 		}
 
-		if updatedAt != nil{
-			a.UpdatedAt = *updatedAt
-		}
+		setTime(&createdAt, &a.CreatedAt, &a.CreateTime)
+		setTime(updatedAt, &a.UpdatedAt, &a.UpdateTime)
 
 		resp.Authors = append(resp.Authors, a)
 	}
+	if err := rows.Err(); err != nil {
+		return resp, dbError(ctx, err)
+	}
 
-	return resp, err
+	if len(resp.Authors) > int(input.Limit) {
+		resp.Authors = resp.Authors[:input.Limit]
+		last := resp.Authors[len(resp.Authors)-1]
+		resp.NextPageToken = encodePageToken(pageCursor{Sort: "created_at", Value: cursorTime(last.CreateTime), Id: last.Id})
+	}
+
+	return resp, nil
 }
 
-func (stg Postgres) UpdateAuthor(input *blogpost.UpdateAuthorRequest) error {
-	res, err := stg.db.NamedExec("UPDATE author  SET fullname=:fn, updated_at=now() WHERE deleted_at IS NULL AND id=:id", map[string]interface{}{
-		"id": input.Id,
-		"fn": input.Fullname,
-	})
+// authorUpdateFields maps the update_mask paths UpdateAuthor accepts to
+// the columns they write. The legacy fullname path writes display_name.
+var authorUpdateFields = map[string][]string{
+	"fullname":             {"fullname"},
+	"profile":              authorProfileColumns,
+	"profile.given_name":   {"given_name"},
+	"profile.family_name":  {"family_name"},
+	"profile.middle_name":  {"middle_name"},
+	"profile.display_name": {"display_name"},
+	"profile.bio":          {"bio"},
+	"profile.avatar_url":   {"avatar_url"},
+	"profile.website":      {"website"},
+	"profile.social_links": {"social_links"},
+}
+
+func (stg Postgres) UpdateAuthor(ctx context.Context, input *blogpost.UpdateAuthorRequest) error {
+	var defaults []string
+	if input.Profile != nil {
+		defaults = append(defaults, "profile")
+	}
+	if input.Fullname != "" || input.Profile == nil {
+		defaults = append(defaults, "fullname")
+	}
+
+	fields, err := maskPaths(input.UpdateMask, authorUpdateFields, defaults...)
 	if err != nil {
 		return err
 	}
 
+	if fields["fullname"] && input.Fullname == "" {
+		return storage.InvalidArgument("fullname", "fullname must not be empty")
+	}
+	// Partial updates leaving no name behind are caught by chk_author_name.
+	if fields["given_name"] && fields["family_name"] && fields["display_name"] && !fields["fullname"] && !hasAuthorName(input.Profile) {
+		return storage.InvalidArgument("profile", "profile must name the author")
+	}
+
+	var args queryArgs
+	set := []string{"version=version+1", "updated_at=now()"}
+
+	values := authorProfileValues(input.Profile)
+	for i, col := range authorProfileColumns {
+		// fullname takes precedence over a display name in the profile.
+		if col == "display_name" && fields["fullname"] {
+			set = append(set, col+"="+args.add(input.Fullname))
+			continue
+		}
+		if fields[col] {
+			set = append(set, col+"="+args.add(values[i]))
+		}
+	}
+
+	where := "deleted_at IS NULL AND id = " + args.add(input.Id)
+	if input.ExpectedVersion != 0 {
+		where += " AND version = " + args.add(input.ExpectedVersion)
+	}
+
+	res, err := stg.q().ExecContext(ctx, `UPDATE author SET `+strings.Join(set, ", ")+` WHERE `+where, args...)
+	if err != nil {
+		return dbError(ctx, err)
+	}
+
 	n, err := res.RowsAffected()
 	if err != nil {
-		return err
+		return dbError(ctx, err)
 	}
 
 	if n > 0 {
 		return nil
 	}
 
-	return errors.New("author not found")
+	return missedRowError(ctx, stg.q(), "author", input.Id, input.ExpectedVersion)
 }
 
-func (stg Postgres) DeleteAuthor(id string) error {
-	res, err := stg.db.Exec("UPDATE author  SET deleted_at=now() WHERE id=$1 AND deleted_at IS NULL", id)
-	if err != nil {
-		return err
+// DeleteAuthor soft-deletes an author, applying input.Policy to the
+// author's articles in the same transaction. It returns the ids of the
+// articles it deleted or reassigned.
+func (stg Postgres) DeleteAuthor(ctx context.Context, input *blogpost.DeleteAuthorRequest) ([]string, error) {
+	if input.Policy == blogpost.DeleteAuthorPolicy_DELETE_AUTHOR_POLICY_REASSIGN {
+		if input.ReassignTo == "" {
+			return nil, storage.InvalidArgument("reassign_to", "reassign_to is required to reassign articles")
+		}
+		if input.ReassignTo == input.Id {
+			return nil, storage.InvalidArgument("reassign_to", "articles can not be reassigned to the deleted author")
+		}
 	}
 
-	n, err := res.RowsAffected()
+	var affected []string
+	err := stg.inTx(ctx, func(tx *sqlx.Tx) error {
+		// The row lock waits for articles being added to this author and
+		// keeps new ones out until we commit.
+		var version int64
+		err := tx.GetContext(ctx, &version, `SELECT version FROM author WHERE id = $1 AND deleted_at IS NULL FOR UPDATE`, input.Id)
+		if err == sql.ErrNoRows {
+			return storage.NotFound("author", input.Id)
+		}
+		if err != nil {
+			return dbError(ctx, err)
+		}
+
+		err = checkVersion("author", input.Id, version, input.ExpectedVersion)
+		if err != nil {
+			return err
+		}
+
+		affected = nil
+		switch input.Policy {
+		case blogpost.DeleteAuthorPolicy_DELETE_AUTHOR_POLICY_CASCADE:
+			err = tx.SelectContext(ctx, &affected, `UPDATE article SET deleted_at=now(), version=version+1 WHERE author_id = $1 AND deleted_at IS NULL RETURNING id`, input.Id)
+		case blogpost.DeleteAuthorPolicy_DELETE_AUTHOR_POLICY_REASSIGN:
+			var targetExists bool
+			err = tx.GetContext(ctx, &targetExists, `SELECT true FROM author WHERE id = $1 AND deleted_at IS NULL FOR SHARE`, input.ReassignTo)
+			if err == sql.ErrNoRows {
+				return storage.ForeignKey("author", input.ReassignTo, "reassign_to")
+			}
+			if err != nil {
+				return dbError(ctx, err)
+			}
+
+			// Deleted articles move too, so they can still be restored.
+			err = tx.SelectContext(ctx, &affected, `UPDATE article SET author_id=$2, version=version+1, updated_at=now() WHERE author_id = $1 RETURNING id`, input.Id, input.ReassignTo)
+		default:
+			var count int64
+			err = tx.GetContext(ctx, &count, `SELECT COUNT(*) FROM article WHERE author_id = $1 AND deleted_at IS NULL`, input.Id)
+			if err != nil {
+				return dbError(ctx, err)
+			}
+			if count > 0 {
+				return storage.InvalidState("author", input.Id, fmt.Sprintf("author still has %d articles, delete or reassign them first", count))
+			}
+		}
+		if err != nil {
+			return dbError(ctx, err)
+		}
+
+		_, err = tx.ExecContext(ctx, `UPDATE author SET deleted_at=now(), version=version+1 WHERE id = $1`, input.Id)
+		return dbError(ctx, err)
+	})
 	if err != nil {
-		return err
+		return nil, err
 	}
+	return affected, nil
+}
 
-	if n > 0 {
-		return nil
+// authorRowSelect lists the columns of the author table scanAuthorRow
+// reads, deleted rows included.
+var authorRowSelect = `id,
+	fullname,
+	created_at,
+	updated_at,
+	deleted_at,
+	version,
+	` + authorProfileSelect
+
+func scanAuthorRow(ctx context.Context, rows *sqlx.Rows) (*blogpost.Author, error) {
+	a := &blogpost.Author{
+		Profile: &blogpost.AuthorProfile{},
 	}
-	return errors.New("author not found")
+	var createdAt time.Time
+	var updatedAt, deletedAt *time.Time
+
+	dest := []interface{}{
+		&a.Id,
+		&a.Fullname,
+		&createdAt,
+		&updatedAt,
+		&deletedAt,
+		&a.Version,
+	}
+	err := rows.Scan(append(dest, authorProfileDest(a.Profile)...)...)
+	if err != nil {
+		return nil, dbError(ctx, err)
+	}
+
+	setTime(&createdAt, &a.CreatedAt, &a.CreateTime)
+	setTime(updatedAt, &a.UpdatedAt, &a.UpdateTime)
+	setTime(deletedAt, &a.DeletedAt, &a.DeleteTime)
+	return a, nil
 }
@@ -0,0 +1,104 @@
+package postgres
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/uacademy/blogpost/article_service/proto-gen/blogpost"
+)
+
+// authorProfileColumns are the author columns backing blogpost.AuthorProfile,
+// in the order authorProfileDest and authorProfileValues use.
+var authorProfileColumns = []string{
+	"given_name",
+	"family_name",
+	"middle_name",
+	"display_name",
+	"bio",
+	"avatar_url",
+	"website",
+	"social_links",
+}
+
+// authorProfileSelect is the select list for authorProfileColumns.
+var authorProfileSelect = strings.Join(authorProfileColumns, ", ")
+
+// authorProfileDest returns scan destinations for authorProfileColumns.
+func authorProfileDest(p *blogpost.AuthorProfile) []interface{} {
+	return []interface{}{
+		&p.GivenName,
+		&p.FamilyName,
+		&p.MiddleName,
+		&p.DisplayName,
+		&p.Bio,
+		&p.AvatarUrl,
+		&p.Website,
+		(*socialLinks)(&p.SocialLinks),
+	}
+}
+
+// authorProfileValues returns the values to write to authorProfileColumns.
+func authorProfileValues(p *blogpost.AuthorProfile) []interface{} {
+	return []interface{}{
+		p.GetGivenName(),
+		p.GetFamilyName(),
+		p.GetMiddleName(),
+		p.GetDisplayName(),
+		p.GetBio(),
+		p.GetAvatarUrl(),
+		p.GetWebsite(),
+		socialLinks(p.GetSocialLinks()),
+	}
+}
+
+// hasAuthorName reports whether p names the author in some way.
+func hasAuthorName(p *blogpost.AuthorProfile) bool {
+	return p.GetDisplayName() != "" || p.GetGivenName() != "" || p.GetFamilyName() != ""
+}
+
+type socialLink struct {
+	Network string `json:"network"`
+	Url     string `json:"url"`
+}
+
+// socialLinks stores social links in a JSONB column.
+type socialLinks []*blogpost.SocialLink
+
+func (l socialLinks) Value() (driver.Value, error) {
+	links := make([]socialLink, 0, len(l))
+	for _, link := range l {
+		links = append(links, socialLink{Network: link.GetNetwork(), Url: link.GetUrl()})
+	}
+	// lib/pq sends []byte as bytea, which jsonb does not accept.
+	data, err := json.Marshal(links)
+	return string(data), err
+}
+
+func (l *socialLinks) Scan(src interface{}) error {
+	var data []byte
+	switch src := src.(type) {
+	case nil:
+		*l = nil
+		return nil
+	case []byte:
+		data = src
+	case string:
+		data = []byte(src)
+	default:
+		return fmt.Errorf("can not scan %T into social links", src)
+	}
+
+	var links []socialLink
+	if err := json.Unmarshal(data, &links); err != nil {
+		return err
+	}
+
+	res := make(socialLinks, 0, len(links))
+	for _, link := range links {
+		res = append(res, &blogpost.SocialLink{Network: link.Network, Url: link.Url})
+	}
+	*l = res
+	return nil
+}
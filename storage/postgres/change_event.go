@@ -0,0 +1,132 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/lib/pq"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/uacademy/blogpost/article_service/proto-gen/blogpost"
+)
+
+var changeTypes = map[string]blogpost.ChangeType{
+	"created": blogpost.ChangeType_CHANGE_TYPE_CREATED,
+	"updated": blogpost.ChangeType_CHANGE_TYPE_UPDATED,
+	"deleted": blogpost.ChangeType_CHANGE_TYPE_DELETED,
+}
+
+// changeEvent is a row of the change_event table the triggers of migration
+// 16 fill.
+type changeEvent struct {
+	Seq        int64     `db:"seq"`
+	EntityId   string    `db:"entity_id"`
+	ChangeType string    `db:"change_type"`
+	CreatedAt  time.Time `db:"created_at"`
+}
+
+func (stg Postgres) ReadLastChangeSequence(ctx context.Context) (int64, error) {
+	var seq int64
+	err := stg.q().GetContext(ctx, &seq, `SELECT COALESCE(MAX(seq), 0) FROM change_event`)
+	if err != nil {
+		return 0, dbError(ctx, err)
+	}
+	return seq, nil
+}
+
+func (stg Postgres) readListChangeEvent(ctx context.Context, entityType string, afterSequence int64, limit int32) ([]changeEvent, []string, error) {
+	var events []changeEvent
+	err := stg.q().SelectContext(ctx, &events, `SELECT seq, entity_id, change_type, created_at
+	FROM change_event WHERE entity_type = $1 AND seq > $2
+	ORDER BY seq
+	LIMIT $3`, entityType, afterSequence, limit)
+	if err != nil {
+		return nil, nil, dbError(ctx, err)
+	}
+
+	ids := make([]string, 0, len(events))
+	for _, e := range events {
+		ids = append(ids, e.EntityId)
+	}
+	return events, ids, nil
+}
+
+func (stg Postgres) ReadListArticleEvent(ctx context.Context, afterSequence int64, limit int32) ([]*blogpost.ArticleEvent, error) {
+	events, ids, err := stg.readListChangeEvent(ctx, "article", afterSequence, limit)
+	if err != nil || len(events) == 0 {
+		return nil, err
+	}
+
+	rows, err := stg.q().QueryxContext(ctx, `SELECT `+articleRowSelect+` FROM article WHERE id = ANY($1)`, pq.Array(ids))
+	if err != nil {
+		return nil, dbError(ctx, err)
+	}
+	defer rows.Close()
+
+	articles := make(map[string]*blogpost.Article, len(ids))
+	for rows.Next() {
+		a, err := scanArticleRow(ctx, rows)
+		if err != nil {
+			return nil, err
+		}
+		articles[a.Id] = a
+	}
+	if err := rows.Err(); err != nil {
+		return nil, dbError(ctx, err)
+	}
+
+	res := make([]*blogpost.ArticleEvent, 0, len(events))
+	for _, e := range events {
+		a, ok := articles[e.EntityId]
+		if !ok {
+			a = &blogpost.Article{Id: e.EntityId}
+		}
+		res = append(res, &blogpost.ArticleEvent{
+			Sequence:   e.Seq,
+			Type:       changeTypes[e.ChangeType],
+			Article:    a,
+			CreateTime: timestamppb.New(e.CreatedAt),
+		})
+	}
+	return res, nil
+}
+
+func (stg Postgres) ReadListAuthorEvent(ctx context.Context, afterSequence int64, limit int32) ([]*blogpost.AuthorEvent, error) {
+	events, ids, err := stg.readListChangeEvent(ctx, "author", afterSequence, limit)
+	if err != nil || len(events) == 0 {
+		return nil, err
+	}
+
+	rows, err := stg.q().QueryxContext(ctx, `SELECT `+authorRowSelect+` FROM author WHERE id = ANY($1)`, pq.Array(ids))
+	if err != nil {
+		return nil, dbError(ctx, err)
+	}
+	defer rows.Close()
+
+	authors := make(map[string]*blogpost.Author, len(ids))
+	for rows.Next() {
+		a, err := scanAuthorRow(ctx, rows)
+		if err != nil {
+			return nil, err
+		}
+		authors[a.Id] = a
+	}
+	if err := rows.Err(); err != nil {
+		return nil, dbError(ctx, err)
+	}
+
+	res := make([]*blogpost.AuthorEvent, 0, len(events))
+	for _, e := range events {
+		a, ok := authors[e.EntityId]
+		if !ok {
+			a = &blogpost.Author{Id: e.EntityId}
+		}
+		res = append(res, &blogpost.AuthorEvent{
+			Sequence:   e.Seq,
+			Type:       changeTypes[e.ChangeType],
+			Author:     a,
+			CreateTime: timestamppb.New(e.CreatedAt),
+		})
+	}
+	return res, nil
+}
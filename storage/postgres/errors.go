@@ -0,0 +1,96 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/lib/pq"
+
+	"github.com/uacademy/blogpost/article_service/storage"
+)
+
+// constraintFields maps constraint names to the request field they guard.
+var constraintFields = map[string]string{
+	"article_title_key":     "title",
+	"idx_article_slug":      "slug",
+	"fk_article_author":     "author_id",
+	"chk_article_status":    "status",
+	"tag_name_key":          "name",
+	"article_revision_pkey": "revision",
+	"chk_author_name":       "profile",
+	"user_author_pkey":      "author_id",
+	"fk_user_author_author": "author_id",
+}
+
+// dbError turns postgres errors the caller can act on into storage errors.
+// nil, storage errors and everything else are returned as is. ctx is the
+// context the failed statement ran with.
+func dbError(ctx context.Context, err error) error {
+	for _, ctxErr := range []error{context.DeadlineExceeded, context.Canceled} {
+		if errors.Is(err, ctxErr) {
+			return storage.Canceled(ctxErr)
+		}
+	}
+
+	var pqErr *pq.Error
+	if !errors.As(err, &pqErr) {
+		return err
+	}
+
+	field, ok := constraintFields[pqErr.Constraint]
+	if !ok {
+		field = pqErr.Column
+	}
+
+	switch pqErr.Code.Name() {
+	case "unique_violation":
+		return storage.AlreadyExists(pqErr.Table, field)
+	case "foreign_key_violation":
+		return storage.ForeignKey(pqErr.Table, "", field)
+	case "check_violation", "not_null_violation", "string_data_right_truncation",
+		"invalid_text_representation", "invalid_datetime_format", "datetime_field_overflow", "undefined_object":
+		return storage.InvalidArgument(field, pqErr.Message)
+	case "serialization_failure", "deadlock_detected":
+		return retryableError{storage.Conflict(pqErr.Table, "concurrent update, retry the request")}
+	case "lock_not_available":
+		return storage.Conflict(pqErr.Table, "concurrent update, retry the request")
+	case "query_canceled":
+		// Sent when the driver cancels a query whose context is done,
+		// or when statement_timeout runs out.
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return storage.Canceled(ctxErr)
+		}
+		return storage.Canceled(context.DeadlineExceeded)
+	}
+
+	return err
+}
+
+// checkVersion fails with storage.Conflict when the client expected
+// another version of the row. An expected version of 0 skips the check.
+func checkVersion(resource, id string, current, expected int64) error {
+	if expected == 0 || expected == current {
+		return nil
+	}
+	return storage.Conflict(resource, fmt.Sprintf("%s %s is at version %d, not %d", resource, id, current, expected))
+}
+
+// missedRowError explains why a versioned write to table affected no rows:
+// either the row does not exist or its version moved on.
+func missedRowError(ctx context.Context, q queryer, table, id string, expected int64) error {
+	var current int64
+	err := q.GetContext(ctx, &current, `SELECT version FROM `+table+` WHERE id = $1 AND deleted_at IS NULL`, id)
+	if err == sql.ErrNoRows {
+		return storage.NotFound(table, id)
+	}
+	if err != nil {
+		return dbError(ctx, err)
+	}
+
+	if err := checkVersion(table, id, current, expected); err != nil {
+		return err
+	}
+	return storage.Conflict(table, fmt.Sprintf("%s %s was changed concurrently", table, id))
+}
@@ -0,0 +1,42 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/lib/pq"
+)
+
+func TestDBErrorQueryCanceled(t *testing.T) {
+	queryCanceled := &pq.Error{Code: "57014"}
+
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	timedOut, cancel := context.WithTimeout(context.Background(), 0)
+	defer cancel()
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want error
+	}{
+		{"client cancelled", canceled, context.Canceled},
+		{"deadline exceeded", timedOut, context.DeadlineExceeded},
+		{"statement timeout", context.Background(), context.DeadlineExceeded},
+	}
+	for _, tt := range tests {
+		err := dbError(tt.ctx, queryCanceled)
+		if !errors.Is(err, tt.want) {
+			t.Errorf("%s: got %v, want %v", tt.name, err, tt.want)
+		}
+		other := context.Canceled
+		if tt.want == context.Canceled {
+			other = context.DeadlineExceeded
+		}
+		if errors.Is(err, other) {
+			t.Errorf("%s: %v is also %v", tt.name, err, other)
+		}
+	}
+}
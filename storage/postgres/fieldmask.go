@@ -0,0 +1,35 @@
+package postgres
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/uacademy/blogpost/article_service/storage"
+)
+
+// fieldSet holds the leaf field paths an update writes.
+type fieldSet map[string]bool
+
+// maskPaths resolves an update mask into the leaf fields it names. allowed
+// maps every accepted path to its leaf fields, so a parent path such as
+// content can stand for all of its children. An empty mask means
+// defaults.
+func maskPaths(mask *fieldmaskpb.FieldMask, allowed map[string][]string, defaults ...string) (fieldSet, error) {
+	paths := mask.GetPaths()
+	if len(paths) == 0 {
+		paths = defaults
+	}
+
+	res := fieldSet{}
+	for _, path := range paths {
+		fields, ok := allowed[path]
+		if !ok {
+			return nil, storage.InvalidArgument("update_mask", fmt.Sprintf("unknown or read-only field %q", path))
+		}
+		for _, f := range fields {
+			res[f] = true
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,102 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/golang-migrate/migrate/v4"
+	migratepg "github.com/golang-migrate/migrate/v4/database/postgres"
+	"github.com/golang-migrate/migrate/v4/source/iofs"
+
+	"github.com/uacademy/blogpost/article_service/storage/migrations"
+)
+
+// newMigrate builds a migrator on a dedicated connection, so closing it
+// does not close the shared pool. The postgres driver keeps its version in
+// the schema_migrations table and holds an advisory lock while migrating,
+// which keeps several replicas from racing each other on startup.
+func (stg Postgres) newMigrate() (*migrate.Migrate, error) {
+	src, err := iofs.New(migrations.FS, ".")
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := stg.db.Conn(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	driver, err := migratepg.WithConnection(context.Background(), conn, &migratepg.Config{})
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	return migrate.NewWithInstance("iofs", src, "postgres", driver)
+}
+
+// MigrateUp applies every migration that has not been applied yet.
+func (stg Postgres) MigrateUp() error {
+	m, err := stg.newMigrate()
+	if err != nil {
+		return err
+	}
+	defer m.Close()
+
+	if err := checkDirty(m); err != nil {
+		return err
+	}
+
+	err = m.Up()
+	if err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		return err
+	}
+	return nil
+}
+
+// MigrateDown rolls back the most recently applied migration.
+func (stg Postgres) MigrateDown() error {
+	m, err := stg.newMigrate()
+	if err != nil {
+		return err
+	}
+	defer m.Close()
+
+	if err := checkDirty(m); err != nil {
+		return err
+	}
+
+	return m.Steps(-1)
+}
+
+// MigrationStatus reports the current schema version. version is 0 when
+// nothing has been applied yet.
+func (stg Postgres) MigrationStatus() (version uint, dirty bool, err error) {
+	m, err := stg.newMigrate()
+	if err != nil {
+		return 0, false, err
+	}
+	defer m.Close()
+
+	version, dirty, err = m.Version()
+	if errors.Is(err, migrate.ErrNilVersion) {
+		return 0, false, nil
+	}
+	return version, dirty, err
+}
+
+func checkDirty(m *migrate.Migrate) error {
+	version, dirty, err := m.Version()
+	if errors.Is(err, migrate.ErrNilVersion) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if dirty {
+		return fmt.Errorf("database is dirty at migration %d: fix the schema by hand and reset schema_migrations before migrating again", version)
+	}
+	return nil
+}
@@ -0,0 +1,157 @@
+package postgres
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// changeChannel is the channel record_change_event notifies, with the
+// entity type as payload.
+const changeChannel = "change_event"
+
+// listenerPingInterval is how often the listening connection is checked
+// while no notifications arrive.
+const listenerPingInterval = time.Minute
+
+// listenerMinBackoff and listenerMaxBackoff bound the wait between
+// reconnects and failed LISTEN attempts.
+const (
+	listenerMinBackoff = time.Second
+	listenerMaxBackoff = time.Minute
+)
+
+// ChangeListener implements storage.ChangeNotifier with LISTEN on a
+// connection of its own. The notifications are sent on commit by whichever
+// replica made the change, so every replica hears about all of them.
+type ChangeListener struct {
+	listener *pq.Listener
+
+	mu     sync.Mutex
+	subs   map[chan struct{}]string
+	closed bool
+}
+
+// NewChangeListener ...
+func NewChangeListener(psqlConfig string) *ChangeListener {
+	return &ChangeListener{
+		listener: pq.NewListener(psqlConfig, listenerMinBackoff, listenerMaxBackoff, func(ev pq.ListenerEventType, err error) {
+			if err != nil {
+				log.Printf("change listener: %v", err)
+			}
+		}),
+		subs: map[chan struct{}]string{},
+	}
+}
+
+// Run forwards notifications to the subscribers until ctx is done, then
+// closes their channels.
+func (l *ChangeListener) Run(ctx context.Context) {
+	defer l.close()
+
+	if !l.listen(ctx) {
+		return
+	}
+
+	ticker := time.NewTicker(listenerPingInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case n := <-l.listener.Notify:
+			// n is nil after a reconnect, when notifications may have
+			// been missed.
+			if n == nil {
+				l.wake("")
+			} else {
+				l.wake(n.Extra)
+			}
+		case <-ticker.C:
+			go l.listener.Ping()
+		}
+	}
+}
+
+// listen issues LISTEN until it succeeds, backing off between attempts,
+// and reports false when ctx is done first. Streams subscribed meanwhile
+// are woken once it succeeds, so they read whatever they have missed from
+// the sequence they were at.
+func (l *ChangeListener) listen(ctx context.Context) bool {
+	backoff := listenerMinBackoff
+	for {
+		err := l.listener.Listen(changeChannel)
+		if err == nil || err == pq.ErrChannelAlreadyOpen {
+			l.wake("")
+			return true
+		}
+		log.Printf("change listener: listen: %v, retrying in %s", err, backoff)
+
+		select {
+		case <-ctx.Done():
+			return false
+		case <-time.After(backoff):
+		}
+
+		backoff *= 2
+		if backoff > listenerMaxBackoff {
+			backoff = listenerMaxBackoff
+		}
+	}
+}
+
+// Subscribe implements storage.ChangeNotifier.
+func (l *ChangeListener) Subscribe(entityType string) (<-chan struct{}, func()) {
+	ch := make(chan struct{}, 1)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.closed {
+		close(ch)
+		return ch, func() {}
+	}
+	l.subs[ch] = entityType
+
+	return ch, func() {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		if _, ok := l.subs[ch]; ok {
+			delete(l.subs, ch)
+			close(ch)
+		}
+	}
+}
+
+// wake signals the subscribers to entityType, or all of them when it is
+// empty. A subscriber that has not caught up with the last signal yet
+// needs no second one.
+func (l *ChangeListener) wake(entityType string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for ch, t := range l.subs {
+		if entityType != "" && t != entityType {
+			continue
+		}
+		select {
+		case ch <- struct{}{}:
+		default:
+		}
+	}
+}
+
+func (l *ChangeListener) close() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.closed = true
+	for ch := range l.subs {
+		delete(l.subs, ch)
+		close(ch)
+	}
+	if err := l.listener.Close(); err != nil {
+		log.Printf("change listener: close: %v", err)
+	}
+}
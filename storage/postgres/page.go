@@ -0,0 +1,49 @@
+package postgres
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strconv"
+
+	"github.com/uacademy/blogpost/article_service/storage"
+)
+
+// queryArgs collects positional arguments while a query is being built.
+type queryArgs []interface{}
+
+// add appends v and returns its placeholder.
+func (a *queryArgs) add(v interface{}) string {
+	*a = append(*a, v)
+	return "$" + strconv.Itoa(len(*a))
+}
+
+// pageCursor points at the last row of a page: the value of the column
+// the list is sorted by and the row id breaking ties. Sort names the
+// ordering the token was issued for, so it can't be replayed against
+// another one.
+type pageCursor struct {
+	Sort  string `json:"s"`
+	Value string `json:"v"`
+	Id    string `json:"i"`
+}
+
+func encodePageToken(c pageCursor) string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodePageToken(token, sort string) (pageCursor, error) {
+	var c pageCursor
+
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return c, storage.InvalidArgument("page_token", "invalid page token")
+	}
+
+	err = json.Unmarshal(b, &c)
+	if err != nil || c.Sort != sort || c.Id == "" {
+		return c, storage.InvalidArgument("page_token", "invalid page token")
+	}
+
+	return c, nil
+}
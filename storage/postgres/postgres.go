@@ -1,23 +1,85 @@
 package postgres
 
 import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
 	"github.com/jmoiron/sqlx"
 	_ "github.com/lib/pq"
 )
 
 type Postgres struct{
 	db *sqlx.DB
+
+	// tx is set on the copies WithTx hands out; queries then run in it.
+	tx *sqlx.Tx
+
+	txOptions *sql.TxOptions
+	txRetries int
+}
+
+// TxConfig controls the transactions storage operations run in.
+type TxConfig struct {
+	// Isolation is a postgres isolation level such as "read committed",
+	// "repeatable read" or "serializable".
+	Isolation string
+	// MaxRetries is how many times a transaction failing with a
+	// serialization error or deadlock is run again.
+	MaxRetries int
 }
 
-func InitDb(psqlConfig string) (*Postgres, error){
+var isolationLevels = map[string]sql.IsolationLevel{
+	"":                sql.LevelDefault,
+	"read committed":  sql.LevelReadCommitted,
+	"repeatable read": sql.LevelRepeatableRead,
+	"serializable":    sql.LevelSerializable,
+}
+
+func InitDb(psqlConfig string, txConfig TxConfig) (*Postgres, error){
 	var err error
 
+	isolation, ok := isolationLevels[strings.ToLower(strings.TrimSpace(txConfig.Isolation))]
+	if !ok {
+		return nil, fmt.Errorf("unknown transaction isolation level %q", txConfig.Isolation)
+	}
+
 	tempDb, err := sqlx.Connect("postgres", psqlConfig)
 	if err != nil{
 		return nil, err
 	}
 
 	return &Postgres{
-		db: tempDb,
+		db:        tempDb,
+		txOptions: &sql.TxOptions{Isolation: isolation},
+		txRetries: txConfig.MaxRetries,
 	}, nil
 }
+
+// Close closes the connection pool.
+func (p *Postgres) Close() error {
+	return p.db.Close()
+}
+
+// Ping checks that the database is reachable.
+func (p *Postgres) Ping(ctx context.Context) error {
+	return p.db.PingContext(ctx)
+}
+
+// queryer is the part of the sqlx API shared by *sqlx.DB and *sqlx.Tx.
+type queryer interface {
+	sqlx.ExtContext
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+	GetContext(ctx context.Context, dest interface{}, query string, args ...interface{}) error
+	SelectContext(ctx context.Context, dest interface{}, query string, args ...interface{}) error
+	NamedExecContext(ctx context.Context, query string, arg interface{}) (sql.Result, error)
+}
+
+// q returns the transaction stg is bound to, or the pool.
+func (stg Postgres) q() queryer {
+	if stg.tx != nil {
+		return stg.tx
+	}
+	return stg.db
+}
@@ -0,0 +1,195 @@
+package postgres
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+
+	"github.com/uacademy/blogpost/article_service/proto-gen/blogpost"
+	"github.com/uacademy/blogpost/article_service/storage"
+)
+
+const defaultSearchLanguage = "english"
+
+// searchLanguages are the text search configurations shipped with every
+// supported PostgreSQL version. Only these reach ::regconfig.
+var searchLanguages = map[string]bool{
+	"simple":     true,
+	"danish":     true,
+	"dutch":      true,
+	"english":    true,
+	"finnish":    true,
+	"french":     true,
+	"german":     true,
+	"hungarian":  true,
+	"italian":    true,
+	"norwegian":  true,
+	"portuguese": true,
+	"romanian":   true,
+	"russian":    true,
+	"spanish":    true,
+	"swedish":    true,
+	"turkish":    true,
+}
+
+// searchLanguage returns language, or the default when it is empty, and
+// rejects configurations outside searchLanguages.
+func searchLanguage(field, language string) (string, error) {
+	if language == "" {
+		return defaultSearchLanguage, nil
+	}
+	if !searchLanguages[language] {
+		names := make([]string, 0, len(searchLanguages))
+		for name := range searchLanguages {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return "", storage.InvalidArgument(field, "unknown language, expected one of "+strings.Join(names, ", "))
+	}
+	return language, nil
+}
+
+// tsQuoter quotes a word as a tsquery lexeme.
+var tsQuoter = strings.NewReplacer(`'`, `''`, `\`, `\\`)
+
+// htmlEscaped wraps the SQL text expression expr so it evaluates to the
+// HTML-escaped text. ts_headline keeps entities intact, so its output is
+// safe to render once the <mark> tags are added.
+func htmlEscaped(expr string) string {
+	return `replace(replace(replace(replace(replace(` + expr + `, '&', '&amp;'), '<', '&lt;'), '>', '&gt;'), '"', '&quot;'), '''', '&#39;')`
+}
+
+// tsQuery builds a tsquery expression out of user input. Quoted parts
+// become phrase queries, words ending with * become prefix queries and
+// everything else has to match as well. It returns "" when nothing
+// searchable is left.
+func tsQuery(args *queryArgs, language, input string) string {
+	var parts []string
+
+	for i, chunk := range strings.Split(input, `"`) {
+		// Odd chunks were inside quotes.
+		if i%2 == 1 {
+			if strings.TrimSpace(chunk) != "" {
+				parts = append(parts, "phraseto_tsquery("+language+", "+args.add(chunk)+")")
+			}
+			continue
+		}
+
+		for _, word := range strings.Fields(chunk) {
+			if strings.HasSuffix(word, "*") {
+				word = strings.TrimRight(word, "*")
+				if word != "" {
+					parts = append(parts, "to_tsquery("+language+", "+args.add("'"+tsQuoter.Replace(word)+"':*")+")")
+				}
+				continue
+			}
+			parts = append(parts, "plainto_tsquery("+language+", "+args.add(word)+")")
+		}
+	}
+
+	return strings.Join(parts, " && ")
+}
+
+func (stg Postgres) SearchArticles(ctx context.Context, input *blogpost.SearchArticlesRequest) (*blogpost.SearchArticlesResponse, error) {
+	resp := &blogpost.SearchArticlesResponse{
+		Results: make([]*blogpost.SearchArticlesResult, 0),
+	}
+
+	language, err := searchLanguage("language", input.Language)
+	if err != nil {
+		return resp, err
+	}
+
+	var args queryArgs
+	lang := args.add(language) + "::regconfig"
+
+	query := tsQuery(&args, lang, input.Query)
+	if query == "" {
+		return resp, nil
+	}
+
+	where := "a.deleted_at IS NULL AND a.search_vector @@ q.query"
+	if name, ok := articleStatusNames[input.Status]; ok {
+		where += " AND a.status = " + args.add(name)
+	}
+
+	// Headlines are costly, so they are only built for the requested page.
+	rows, err := stg.q().QueryxContext(ctx, `WITH q AS (SELECT `+query+` AS query),
+	hits AS (
+		SELECT a.*, ts_rank('{0.1, 0.2, 0.4, 1.0}', a.search_vector, q.query) AS rank
+		FROM article a, q WHERE `+where+`
+		ORDER BY rank DESC, a.created_at DESC, a.id
+		LIMIT `+args.add(input.Limit)+`
+		OFFSET `+args.add(input.Offset)+`
+	)
+	SELECT
+	h.id,
+	h.title,
+	h.body,
+	h.slug,
+	h.language,
+	h.author_id,
+	h.status,
+	h.published_at,
+	h.created_at,
+	h.updated_at,
+	h.version,
+	ARRAY(SELECT t.name FROM article_tag at JOIN tag t ON t.id = at.tag_id WHERE at.article_id = h.id ORDER BY t.name),
+	h.rank,
+	ts_headline(`+lang+`, `+htmlEscaped("h.title")+`, q.query, 'HighlightAll=true, StartSel=<mark>, StopSel=</mark>'),
+	ts_headline(`+lang+`, `+htmlEscaped("h.body")+`, q.query, 'MaxFragments=3, MaxWords=35, MinWords=15, StartSel=<mark>, StopSel=</mark>')
+	FROM hits h, q
+	ORDER BY h.rank DESC, h.created_at DESC, h.id
+	`, args...)
+	if err != nil {
+		return resp, dbError(ctx, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		a := &blogpost.Article{
+			Content: &blogpost.Content{},
+		}
+		r := &blogpost.SearchArticlesResult{
+			Article: a,
+		}
+
+		var createdAt time.Time
+		var updatedAt, publishedAt *time.Time
+		var status string
+
+		err := rows.Scan(
+			&a.Id,
+			&a.Content.Title,
+			&a.Content.Body,
+			&a.Slug,
+			&a.Language,
+			&a.AuthorId,
+			&status,
+			&publishedAt,
+			&createdAt,
+			&updatedAt,
+			&a.Version,
+			(*pq.StringArray)(&a.Tags),
+			&r.Rank,
+			&r.TitleHighlight,
+			&r.Snippet,
+		)
+		if err != nil {
+			return resp, dbError(ctx, err)
+		}
+
+		a.Status = articleStatusFromName(status)
+
+		setTime(&createdAt, &a.CreatedAt, &a.CreateTime)
+		setTime(updatedAt, &a.UpdatedAt, &a.UpdateTime)
+		setTime(publishedAt, &a.PublishedAt, &a.PublishTime)
+
+		resp.Results = append(resp.Results, r)
+	}
+
+	return resp, dbError(ctx, rows.Err())
+}
@@ -0,0 +1,130 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/gosimple/slug"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+const maxSlugLength = 200
+
+// slugRetries bounds how often a write picks another slug after a
+// concurrent transaction committed the one it picked.
+const slugRetries = 3
+
+// makeSlug turns a title into a lowercase ASCII slug, transliterating
+// non-ASCII letters.
+func makeSlug(title string) string {
+	s := slug.Make(title)
+	if len(s) > maxSlugLength {
+		s = strings.Trim(s[:maxSlugLength], "-")
+	}
+	if s == "" {
+		s = "article"
+	}
+	return s
+}
+
+// uniqueArticleSlug returns base, or base with the smallest free numeric
+// suffix. Slugs still used as redirects count as taken unless they point
+// to the same article.
+func uniqueArticleSlug(ctx context.Context, tx *sqlx.Tx, articleId, base string) (string, error) {
+	var taken []string
+	err := tx.SelectContext(ctx, &taken, `SELECT slug FROM article WHERE (slug = $1 OR slug LIKE $2) AND id <> $3
+	UNION SELECT slug FROM article_slug_redirect WHERE (slug = $1 OR slug LIKE $2) AND article_id <> $3`,
+		base, base+"-%", articleId)
+	if err != nil {
+		return "", dbError(ctx, err)
+	}
+
+	used := make(map[string]bool, len(taken))
+	for _, s := range taken {
+		used[s] = true
+	}
+
+	if !used[base] {
+		return base, nil
+	}
+	for i := 2; ; i++ {
+		candidate := fmt.Sprintf("%s-%d", base, i)
+		if !used[candidate] {
+			return candidate, nil
+		}
+	}
+}
+
+// withUniqueSlug calls write with the slug uniqueArticleSlug picks for
+// base. Picking a slug and writing it is not atomic, so when a concurrent
+// transaction commits the same slug first, write is rolled back to a
+// savepoint and called again with a fresh pick. write must return the
+// errors of the driver unwrapped.
+func withUniqueSlug(ctx context.Context, tx *sqlx.Tx, articleId, base string, write func(slug string) error) error {
+	for attempt := 0; ; attempt++ {
+		slug, err := uniqueArticleSlug(ctx, tx, articleId, base)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, `SAVEPOINT article_slug`)
+		if err != nil {
+			return dbError(ctx, err)
+		}
+
+		err = write(slug)
+		if err == nil {
+			_, err = tx.ExecContext(ctx, `RELEASE SAVEPOINT article_slug`)
+			return dbError(ctx, err)
+		}
+		if !isSlugTaken(err) || attempt >= slugRetries {
+			return dbError(ctx, err)
+		}
+
+		_, err = tx.ExecContext(ctx, `ROLLBACK TO SAVEPOINT article_slug`)
+		if err != nil {
+			return dbError(ctx, err)
+		}
+	}
+}
+
+// isSlugTaken reports whether err is a violation of the unique index on
+// article slugs.
+func isSlugTaken(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code.Name() == "unique_violation" && pqErr.Constraint == "idx_article_slug"
+}
+
+// changeArticleSlug keeps the old slug of an article as a redirect when
+// its title changes, and returns the slug the article should use now
+// along with its base. currentBase is the base the current slug was made
+// from.
+func changeArticleSlug(ctx context.Context, tx *sqlx.Tx, articleId, currentSlug, currentBase, title string) (string, string, error) {
+	base := makeSlug(title)
+	if base == currentBase {
+		return currentSlug, base, nil
+	}
+
+	newSlug, err := uniqueArticleSlug(ctx, tx, articleId, base)
+	if err != nil {
+		return "", "", dbError(ctx, err)
+	}
+	if newSlug == currentSlug {
+		return currentSlug, base, nil
+	}
+
+	_, err = tx.ExecContext(ctx, `DELETE FROM article_slug_redirect WHERE slug = $1 AND article_id = $2`, newSlug, articleId)
+	if err != nil {
+		return "", "", dbError(ctx, err)
+	}
+
+	_, err = tx.ExecContext(ctx, `INSERT INTO article_slug_redirect (slug, article_id) VALUES ($1, $2) ON CONFLICT (slug) DO NOTHING`, currentSlug, articleId)
+	if err != nil {
+		return "", "", dbError(ctx, err)
+	}
+
+	return newSlug, base, nil
+}
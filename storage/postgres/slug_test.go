@@ -0,0 +1,27 @@
+package postgres
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/lib/pq"
+)
+
+func TestIsSlugTaken(t *testing.T) {
+	tests := []struct {
+		err  error
+		want bool
+	}{
+		{&pq.Error{Code: "23505", Constraint: "idx_article_slug"}, true},
+		{fmt.Errorf("insert: %w", &pq.Error{Code: "23505", Constraint: "idx_article_slug"}), true},
+		{&pq.Error{Code: "23505", Constraint: "article_title_key"}, false},
+		{&pq.Error{Code: "23503", Constraint: "idx_article_slug"}, false},
+		{errors.New("connection reset"), false},
+	}
+	for _, tt := range tests {
+		if got := isSlugTaken(tt.err); got != tt.want {
+			t.Errorf("isSlugTaken(%v) = %v, want %v", tt.err, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package postgres
+
+import (
+	"context"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+
+	"github.com/uacademy/blogpost/article_service/proto-gen/blogpost"
+)
+
+// normalizeTags lowercases and trims tag names, dropping empty and
+// duplicate ones.
+func normalizeTags(tags []string) []string {
+	res := make([]string, 0, len(tags))
+	seen := make(map[string]bool, len(tags))
+	for _, t := range tags {
+		t = strings.ToLower(strings.TrimSpace(t))
+		if t == "" || seen[t] {
+			continue
+		}
+		seen[t] = true
+		res = append(res, t)
+	}
+	return res
+}
+
+// setArticleTags replaces the tags of an article, creating missing tags on the way.
+func setArticleTags(ctx context.Context, tx *sqlx.Tx, articleId string, tags []string) error {
+	tags = normalizeTags(tags)
+
+	_, err := tx.ExecContext(ctx, `DELETE FROM article_tag WHERE article_id = $1`, articleId)
+	if err != nil {
+		return dbError(ctx, err)
+	}
+
+	if len(tags) == 0 {
+		return nil
+	}
+
+	_, err = tx.ExecContext(ctx, `INSERT INTO tag (name) SELECT unnest($1::varchar[]) ON CONFLICT (name) DO NOTHING`, pq.Array(tags))
+	if err != nil {
+		return dbError(ctx, err)
+	}
+
+	_, err = tx.ExecContext(ctx, `INSERT INTO article_tag (article_id, tag_id) SELECT $1, id FROM tag WHERE name = ANY($2)`, articleId, pq.Array(tags))
+	return dbError(ctx, err)
+}
+
+// likeEscaper escapes the LIKE wildcards and the default escape character.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// escapeLike makes s match literally inside a LIKE pattern.
+func escapeLike(s string) string {
+	return likeEscaper.Replace(s)
+}
+
+func (stg Postgres) ReadListTag(ctx context.Context, offset, limit int, search string) (*blogpost.ListTagsResponse, error) {
+	resp := &blogpost.ListTagsResponse{
+		Tags: make([]*blogpost.Tag, 0),
+	}
+
+	rows, err := stg.q().QueryxContext(ctx, `SELECT
+	t.name,
+	COUNT(a.id)
+	FROM tag t
+	LEFT JOIN article_tag at ON at.tag_id = t.id
+	LEFT JOIN article a ON a.id = at.article_id AND a.deleted_at IS NULL AND a.status = 'published'
+	WHERE t.name ILIKE '%' || $1 || '%'
+	GROUP BY t.id, t.name
+	ORDER BY COUNT(a.id) DESC, t.name
+	LIMIT $2
+	OFFSET $3
+	`, escapeLike(search), limit, offset)
+	if err != nil {
+		return resp, dbError(ctx, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		t := &blogpost.Tag{}
+
+		err := rows.Scan(&t.Name, &t.ArticleCount)
+		if err != nil {
+			return resp, dbError(ctx, err)
+		}
+
+		resp.Tags = append(resp.Tags, t)
+	}
+
+	return resp, dbError(ctx, rows.Err())
+}
@@ -0,0 +1,28 @@
+package postgres
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// formatTime is the format of the deprecated string timestamps and of
+// page cursors.
+func formatTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339Nano)
+}
+
+// setTime fills a deprecated string timestamp and the Timestamp replacing
+// it. A nil t leaves both unset.
+func setTime(t *time.Time, str *string, ts **timestamppb.Timestamp) {
+	if t == nil {
+		return
+	}
+	*str = formatTime(*t)
+	*ts = timestamppb.New(*t)
+}
+
+// cursorTime formats ts for a page cursor.
+func cursorTime(ts *timestamppb.Timestamp) string {
+	return formatTime(ts.AsTime())
+}
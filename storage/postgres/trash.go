@@ -0,0 +1,288 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+
+	"github.com/uacademy/blogpost/article_service/proto-gen/blogpost"
+	"github.com/uacademy/blogpost/article_service/storage"
+)
+
+// purgeLockKey is the advisory lock replicas take so only one of them
+// purges at a time.
+const purgeLockKey = "article_service_purge"
+
+// RestoreArticle clears deleted_at on a soft-deleted article whose author
+// is still around.
+func (stg Postgres) RestoreArticle(ctx context.Context, id string, expectedVersion int64) error {
+	return stg.inTx(ctx, func(tx *sqlx.Tx) error {
+		var current struct {
+			Version  int64
+			Deleted  bool
+			AuthorId sql.NullString `db:"author_id"`
+		}
+		err := tx.GetContext(ctx, &current, `SELECT version, deleted_at IS NOT NULL AS deleted, author_id FROM article WHERE id = $1 FOR UPDATE`, id)
+		if err == sql.ErrNoRows {
+			return storage.NotFound("article", id)
+		}
+		if err != nil {
+			return dbError(ctx, err)
+		}
+
+		if !current.Deleted {
+			return storage.InvalidState("article", id, "article is not deleted")
+		}
+
+		err = checkVersion("article", id, current.Version, expectedVersion)
+		if err != nil {
+			return err
+		}
+
+		var authorExists bool
+		err = tx.GetContext(ctx, &authorExists, `SELECT true FROM author WHERE id = $1 AND deleted_at IS NULL FOR SHARE`, current.AuthorId)
+		if err == sql.ErrNoRows {
+			return storage.ForeignKey("author", current.AuthorId.String, "author_id")
+		}
+		if err != nil {
+			return dbError(ctx, err)
+		}
+
+		_, err = tx.ExecContext(ctx, `UPDATE article SET deleted_at=NULL, version=version+1, updated_at=now() WHERE id = $1`, id)
+		return dbError(ctx, err)
+	})
+}
+
+func (stg Postgres) ReadListDeletedArticle(ctx context.Context, input *blogpost.ListDeletedArticlesRequest) (*blogpost.ListDeletedArticlesResponse, error) {
+	resp := &blogpost.ListDeletedArticlesResponse{
+		Articles: make([]*blogpost.Article, 0),
+	}
+
+	var args queryArgs
+	where := "deleted_at IS NOT NULL"
+
+	if input.PageToken != "" {
+		cursor, err := decodePageToken(input.PageToken, "deleted_at")
+		if err != nil {
+			return resp, err
+		}
+		where += " AND (deleted_at, id) < (" + args.add(cursor.Value) + "::timestamptz, " + args.add(cursor.Id) + ")"
+	}
+
+	query := `SELECT ` + articleRowSelect + ` FROM article WHERE ` + where + `
+	ORDER BY deleted_at DESC, id DESC
+	LIMIT ` + args.add(input.Limit+1)
+
+	if input.PageToken == "" {
+		query += " OFFSET " + args.add(input.Offset)
+	}
+
+	rows, err := stg.q().QueryxContext(ctx, query, args...)
+	if err != nil {
+		return resp, dbError(ctx, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		a, err := scanArticleRow(ctx, rows)
+		if err != nil {
+			return resp, err
+		}
+		resp.Articles = append(resp.Articles, a)
+	}
+	if err := rows.Err(); err != nil {
+		return resp, dbError(ctx, err)
+	}
+
+	if len(resp.Articles) > int(input.Limit) {
+		resp.Articles = resp.Articles[:input.Limit]
+		last := resp.Articles[len(resp.Articles)-1]
+		resp.NextPageToken = encodePageToken(pageCursor{Sort: "deleted_at", Value: cursorTime(last.DeleteTime), Id: last.Id})
+	}
+
+	return resp, nil
+}
+
+// RestoreAuthor clears deleted_at on a soft-deleted author.
+func (stg Postgres) RestoreAuthor(ctx context.Context, id string, expectedVersion int64) error {
+	return stg.inTx(ctx, func(tx *sqlx.Tx) error {
+		var current struct {
+			Version int64
+			Deleted bool
+		}
+		err := tx.GetContext(ctx, &current, `SELECT version, deleted_at IS NOT NULL AS deleted FROM author WHERE id = $1 FOR UPDATE`, id)
+		if err == sql.ErrNoRows {
+			return storage.NotFound("author", id)
+		}
+		if err != nil {
+			return dbError(ctx, err)
+		}
+
+		if !current.Deleted {
+			return storage.InvalidState("author", id, "author is not deleted")
+		}
+
+		err = checkVersion("author", id, current.Version, expectedVersion)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, `UPDATE author SET deleted_at=NULL, version=version+1, updated_at=now() WHERE id = $1`, id)
+		return dbError(ctx, err)
+	})
+}
+
+func (stg Postgres) ReadListDeletedAuthor(ctx context.Context, input *blogpost.ListDeletedAuthorsRequest) (*blogpost.ListDeletedAuthorsResponse, error) {
+	resp := &blogpost.ListDeletedAuthorsResponse{
+		Authors: make([]*blogpost.Author, 0),
+	}
+
+	var args queryArgs
+	where := "deleted_at IS NOT NULL"
+
+	if input.PageToken != "" {
+		cursor, err := decodePageToken(input.PageToken, "deleted_at")
+		if err != nil {
+			return resp, err
+		}
+		where += " AND (deleted_at, id) < (" + args.add(cursor.Value) + "::timestamptz, " + args.add(cursor.Id) + ")"
+	}
+
+	query := `SELECT ` + authorRowSelect + ` FROM author WHERE ` + where + `
+	ORDER BY deleted_at DESC, id DESC
+	LIMIT ` + args.add(input.Limit+1)
+
+	if input.PageToken == "" {
+		query += " OFFSET " + args.add(input.Offset)
+	}
+
+	rows, err := stg.q().QueryxContext(ctx, query, args...)
+	if err != nil {
+		return resp, dbError(ctx, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		a, err := scanAuthorRow(ctx, rows)
+		if err != nil {
+			return resp, err
+		}
+		resp.Authors = append(resp.Authors, a)
+	}
+	if err := rows.Err(); err != nil {
+		return resp, dbError(ctx, err)
+	}
+
+	if len(resp.Authors) > int(input.Limit) {
+		resp.Authors = resp.Authors[:input.Limit]
+		last := resp.Authors[len(resp.Authors)-1]
+		resp.NextPageToken = encodePageToken(pageCursor{Sort: "deleted_at", Value: cursorTime(last.DeleteTime), Id: last.Id})
+	}
+
+	return resp, nil
+}
+
+// PurgeDeleted hard-deletes up to limit articles and limit authors that
+// were soft-deleted more than olderThan ago, along with the rows hanging
+// off those articles. Authors who still have articles, deleted or not,
+// are kept. When another replica is purging it returns zero counts.
+func (stg Postgres) PurgeDeleted(ctx context.Context, olderThan time.Duration, limit int) (articles, authors int64, err error) {
+	// The cutoff is computed by postgres, so replica clocks don't matter.
+	age := olderThan.Seconds()
+
+	err = stg.inTx(ctx, func(tx *sqlx.Tx) error {
+		var locked bool
+		err := tx.GetContext(ctx, &locked, `SELECT pg_try_advisory_xact_lock(hashtext($1))`, purgeLockKey)
+		if err != nil {
+			return dbError(ctx, err)
+		}
+		if !locked {
+			return nil
+		}
+
+		var ids []string
+		err = tx.SelectContext(ctx, &ids, `SELECT id FROM article WHERE deleted_at < now() - make_interval(secs => $1) ORDER BY deleted_at LIMIT $2 FOR UPDATE SKIP LOCKED`, age, limit)
+		if err != nil {
+			return dbError(ctx, err)
+		}
+
+		if len(ids) > 0 {
+			for _, table := range []string{"article_tag", "article_revision", "article_slug_redirect"} {
+				_, err = tx.ExecContext(ctx, `DELETE FROM `+table+` WHERE article_id = ANY($1)`, pq.Array(ids))
+				if err != nil {
+					return dbError(ctx, err)
+				}
+			}
+
+			_, err = tx.ExecContext(ctx, `DELETE FROM article WHERE id = ANY($1)`, pq.Array(ids))
+			if err != nil {
+				return dbError(ctx, err)
+			}
+		}
+		articles = int64(len(ids))
+
+		res, err := tx.ExecContext(ctx, `DELETE FROM author WHERE id IN (
+			SELECT id FROM author au WHERE deleted_at < now() - make_interval(secs => $1)
+			AND NOT EXISTS (SELECT 1 FROM article ar WHERE ar.author_id = au.id)
+			ORDER BY deleted_at LIMIT $2 FOR UPDATE SKIP LOCKED
+		)`, age, limit)
+		if err != nil {
+			return dbError(ctx, err)
+		}
+
+		authors, err = res.RowsAffected()
+		return dbError(ctx, err)
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+	return articles, authors, nil
+}
+
+func (stg Postgres) ReadArticlesById(ctx context.Context, ids []string) ([]*blogpost.Article, error) {
+	return stg.readArticles(ctx, `id = ANY($1)`, pq.Array(ids))
+}
+
+func (stg Postgres) ReadListArticleByAuthor(ctx context.Context, authorId string) ([]*blogpost.Article, error) {
+	return stg.readArticles(ctx, `author_id = $1`, authorId)
+}
+
+// readArticles reads the articles matching where, deleted ones included.
+func (stg Postgres) readArticles(ctx context.Context, where string, args ...interface{}) ([]*blogpost.Article, error) {
+	rows, err := stg.q().QueryxContext(ctx, `SELECT `+articleRowSelect+` FROM article WHERE `+where, args...)
+	if err != nil {
+		return nil, dbError(ctx, err)
+	}
+	defer rows.Close()
+
+	var res []*blogpost.Article
+	for rows.Next() {
+		a, err := scanArticleRow(ctx, rows)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, a)
+	}
+	return res, dbError(ctx, rows.Err())
+}
+
+func (stg Postgres) ReadAuthorsById(ctx context.Context, ids []string) ([]*blogpost.Author, error) {
+	rows, err := stg.q().QueryxContext(ctx, `SELECT `+authorRowSelect+` FROM author WHERE id = ANY($1)`, pq.Array(ids))
+	if err != nil {
+		return nil, dbError(ctx, err)
+	}
+	defer rows.Close()
+
+	var res []*blogpost.Author
+	for rows.Next() {
+		a, err := scanAuthorRow(ctx, rows)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, a)
+	}
+	return res, dbError(ctx, rows.Err())
+}
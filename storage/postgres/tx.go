@@ -0,0 +1,88 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+
+	"github.com/uacademy/blogpost/article_service/storage"
+)
+
+// retryableError marks the storage.Conflict returned for a serialization
+// failure or deadlock: running the whole transaction again may succeed.
+type retryableError struct {
+	error
+}
+
+func (e retryableError) Unwrap() error {
+	return e.error
+}
+
+func isRetryable(err error) bool {
+	var r retryableError
+	if errors.As(err, &r) {
+		return true
+	}
+
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		switch pqErr.Code.Name() {
+		case "serialization_failure", "deadlock_detected":
+			return true
+		}
+	}
+	return false
+}
+
+// WithTx runs fn with a storage bound to a single transaction, committed
+// when fn returns nil. Serialization failures and deadlocks restart fn in
+// a fresh transaction, so fn must not have side effects outside storage.
+// Calling WithTx on a storage already bound to a transaction reuses it.
+func (stg Postgres) WithTx(ctx context.Context, fn func(storage.StorageI) error) error {
+	return stg.inTx(ctx, func(tx *sqlx.Tx) error {
+		txStg := stg
+		txStg.tx = tx
+		return fn(txStg)
+	})
+}
+
+// inTx runs fn in the transaction stg is bound to, or in a new one.
+func (stg Postgres) inTx(ctx context.Context, fn func(tx *sqlx.Tx) error) error {
+	if stg.tx != nil {
+		return fn(stg.tx)
+	}
+
+	for attempt := 0; ; attempt++ {
+		err := stg.runTx(ctx, fn)
+		if err == nil || attempt >= stg.txRetries || !isRetryable(err) {
+			return err
+		}
+
+		// Back off a little, with jitter, so the transactions that
+		// collided do not collide again.
+		backoff := time.Duration(attempt+1)*10*time.Millisecond + time.Duration(rand.Int63n(int64(10*time.Millisecond)))
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(backoff):
+		}
+	}
+}
+
+func (stg Postgres) runTx(ctx context.Context, fn func(tx *sqlx.Tx) error) error {
+	tx, err := stg.db.BeginTxx(ctx, stg.txOptions)
+	if err != nil {
+		return dbError(ctx, err)
+	}
+	defer tx.Rollback()
+
+	if err := fn(tx); err != nil {
+		return err
+	}
+
+	return dbError(ctx, tx.Commit())
+}
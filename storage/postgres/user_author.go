@@ -0,0 +1,92 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/uacademy/blogpost/article_service/proto-gen/blogpost"
+	"github.com/uacademy/blogpost/article_service/storage"
+)
+
+// AddUserAuthorLink links a user to a live author.
+func (stg Postgres) AddUserAuthorLink(ctx context.Context, userId, authorId string) error {
+	return stg.inTx(ctx, func(tx *sqlx.Tx) error {
+		// FOR SHARE keeps the author from being deleted until we commit.
+		var authorExists bool
+		err := tx.GetContext(ctx, &authorExists, `SELECT true FROM author WHERE id = $1 AND deleted_at IS NULL FOR SHARE`, authorId)
+		if err == sql.ErrNoRows {
+			return storage.ForeignKey("author", authorId, "author_id")
+		}
+		if err != nil {
+			return dbError(ctx, err)
+		}
+
+		_, err = tx.ExecContext(ctx, `INSERT INTO user_author (user_id, author_id) VALUES ($1, $2)`, userId, authorId)
+		return dbError(ctx, err)
+	})
+}
+
+func (stg Postgres) ReadUserAuthorLink(ctx context.Context, userId, authorId string) (*blogpost.UserAuthorLink, error) {
+	res := &blogpost.UserAuthorLink{}
+	var createdAt time.Time
+
+	err := stg.q().QueryRowContext(ctx, `SELECT user_id, author_id, created_at FROM user_author WHERE user_id = $1 AND author_id = $2`, userId, authorId).Scan(
+		&res.UserId, &res.AuthorId, &createdAt,
+	)
+	if err == sql.ErrNoRows {
+		return nil, storage.NotFound("user author link", userId+"/"+authorId)
+	}
+	if err != nil {
+		return nil, dbError(ctx, err)
+	}
+
+	res.CreateTime = timestamppb.New(createdAt)
+	return res, nil
+}
+
+func (stg Postgres) ReadListUserAuthorLink(ctx context.Context, userId string) (*blogpost.ListUserAuthorsResponse, error) {
+	resp := &blogpost.ListUserAuthorsResponse{
+		Links: make([]*blogpost.UserAuthorLink, 0),
+	}
+
+	rows, err := stg.q().QueryxContext(ctx, `SELECT user_id, author_id, created_at FROM user_author WHERE user_id = $1 ORDER BY created_at, author_id`, userId)
+	if err != nil {
+		return resp, dbError(ctx, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		l := &blogpost.UserAuthorLink{}
+		var createdAt time.Time
+
+		err := rows.Scan(&l.UserId, &l.AuthorId, &createdAt)
+		if err != nil {
+			return resp, dbError(ctx, err)
+		}
+
+		l.CreateTime = timestamppb.New(createdAt)
+		resp.Links = append(resp.Links, l)
+	}
+
+	return resp, dbError(ctx, rows.Err())
+}
+
+func (stg Postgres) DeleteUserAuthorLink(ctx context.Context, userId, authorId string) error {
+	res, err := stg.q().ExecContext(ctx, `DELETE FROM user_author WHERE user_id = $1 AND author_id = $2`, userId, authorId)
+	if err != nil {
+		return dbError(ctx, err)
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return dbError(ctx, err)
+	}
+	if n == 0 {
+		return storage.NotFound("user author link", userId+"/"+authorId)
+	}
+	return nil
+}
@@ -1,19 +1,81 @@
 package storage
 
 import (
+	"context"
+	"time"
+
 	"github.com/uacademy/blogpost/article_service/proto-gen/blogpost"
 )
 
 type StorageI interface {
-	AddArticle(id string, input *blogpost.CreateArticleRequest) error
-	ReadArticleById(id string) (*blogpost.GetArticleByIdResponse, error)
-	ReadListArticle(offset, limit int, search string) (resp *blogpost.GetArticleListResponse, err error)
-	UpdateArticle(input *blogpost.UpdateArticleRequest) error
-	DeleteArticle(id string) error
-
-	AddAuthor(id string, input *blogpost.CreateAuthorRequest) error
-	ReadAuthorById(id string) (*blogpost.GetAuthorByIdResponse, error)
-	ReadListAuthor(offset, limit int, search string) (resp *blogpost.GetAuthorListResponse, err error)
-	UpdateAuthor(input *blogpost.UpdateAuthorRequest) error
-	DeleteAuthor(id string) error
+	// WithTx runs fn against a storage bound to one transaction, which is
+	// committed if fn returns nil and rolled back otherwise. fn may be run
+	// more than once when the transaction hits a serialization failure.
+	WithTx(ctx context.Context, fn func(StorageI) error) error
+
+	AddArticle(ctx context.Context, id string, input *blogpost.CreateArticleRequest, editedBy string) error
+	ReadArticleById(ctx context.Context, id string) (*blogpost.GetArticleByIdResponse, error)
+	ReadArticleBySlug(ctx context.Context, slug string) (*blogpost.GetArticleByIdResponse, error)
+	ReadListArticle(ctx context.Context, input *blogpost.GetArticleListRequest) (resp *blogpost.GetArticleListResponse, err error)
+	SearchArticles(ctx context.Context, input *blogpost.SearchArticlesRequest) (resp *blogpost.SearchArticlesResponse, err error)
+	UpdateArticle(ctx context.Context, input *blogpost.UpdateArticleRequest) error
+	DeleteArticle(ctx context.Context, id string, expectedVersion int64) error
+	RestoreArticle(ctx context.Context, id string, expectedVersion int64) error
+	ReadListDeletedArticle(ctx context.Context, input *blogpost.ListDeletedArticlesRequest) (*blogpost.ListDeletedArticlesResponse, error)
+	SetArticleStatus(ctx context.Context, id string, status blogpost.ArticleStatus) error
+	// ReadArticleAuthorId returns the author of an article, deleted or not.
+	ReadArticleAuthorId(ctx context.Context, id string) (string, error)
+
+	ReadArticleRevision(ctx context.Context, articleId string, revision int) (*blogpost.ArticleRevision, error)
+	ReadListArticleRevision(ctx context.Context, articleId string, offset, limit int) (*blogpost.ListArticleRevisionsResponse, error)
+	RestoreArticleRevision(ctx context.Context, articleId string, revision int, editedBy string) error
+
+	ReadListTag(ctx context.Context, offset, limit int, search string) (resp *blogpost.ListTagsResponse, err error)
+
+	AddAuthor(ctx context.Context, id string, input *blogpost.CreateAuthorRequest) error
+	ReadAuthorById(ctx context.Context, id string) (*blogpost.GetAuthorByIdResponse, error)
+	ReadListAuthor(ctx context.Context, input *blogpost.GetAuthorListRequest) (resp *blogpost.GetAuthorListResponse, err error)
+	UpdateAuthor(ctx context.Context, input *blogpost.UpdateAuthorRequest) error
+	// DeleteAuthor returns the ids of the articles its policy deleted or
+	// reassigned.
+	DeleteAuthor(ctx context.Context, input *blogpost.DeleteAuthorRequest) (articleIds []string, err error)
+	RestoreAuthor(ctx context.Context, id string, expectedVersion int64) error
+	ReadListDeletedAuthor(ctx context.Context, input *blogpost.ListDeletedAuthorsRequest) (*blogpost.ListDeletedAuthorsResponse, error)
+
+	// ReadArticlesById, ReadListArticleByAuthor and ReadAuthorsById read
+	// deleted rows as well, in no particular order.
+	ReadArticlesById(ctx context.Context, ids []string) ([]*blogpost.Article, error)
+	ReadListArticleByAuthor(ctx context.Context, authorId string) ([]*blogpost.Article, error)
+	ReadAuthorsById(ctx context.Context, ids []string) ([]*blogpost.Author, error)
+
+	AddUserAuthorLink(ctx context.Context, userId, authorId string) error
+	ReadUserAuthorLink(ctx context.Context, userId, authorId string) (*blogpost.UserAuthorLink, error)
+	ReadListUserAuthorLink(ctx context.Context, userId string) (*blogpost.ListUserAuthorsResponse, error)
+	DeleteUserAuthorLink(ctx context.Context, userId, authorId string) error
+
+	// AddAuditEvent stores event. Its id and create_time are assigned by
+	// the storage.
+	AddAuditEvent(ctx context.Context, event *blogpost.AuditEvent) error
+	ReadListAuditEvent(ctx context.Context, input *blogpost.ListAuditEventsRequest) (*blogpost.ListAuditEventsResponse, error)
+
+	// ReadListArticleEvent and ReadListAuthorEvent return up to limit
+	// change events after afterSequence, oldest first, each with the
+	// entity as it is now.
+	ReadListArticleEvent(ctx context.Context, afterSequence int64, limit int32) ([]*blogpost.ArticleEvent, error)
+	ReadListAuthorEvent(ctx context.Context, afterSequence int64, limit int32) ([]*blogpost.AuthorEvent, error)
+	// ReadLastChangeSequence returns the sequence of the newest change
+	// event, 0 when there is none.
+	ReadLastChangeSequence(ctx context.Context) (int64, error)
+
+	// PurgeDeleted hard-deletes up to limit articles and limit authors
+	// soft-deleted more than olderThan ago.
+	PurgeDeleted(ctx context.Context, olderThan time.Duration, limit int) (articles, authors int64, err error)
+}
+
+// ChangeNotifier tells watchers that change events have been committed.
+type ChangeNotifier interface {
+	// Subscribe returns a channel that receives a value whenever change
+	// events of entityType may have been added, and a function ending the
+	// subscription. The channel is closed when the notifier stops.
+	Subscribe(entityType string) (<-chan struct{}, func())
 }